@@ -9,6 +9,7 @@ import (
 type ProjectsReq struct {
 	Context ctxtg.Context
 	Tracker entities.Tracker
+	Filter  entities.ProjectFilter
 	entities.Pagination
 }
 
@@ -18,6 +19,18 @@ type ProjectsResp struct {
 	Amount   int64
 }
 
+// ProjectTreeReq input parameter to GetProjectTree
+type ProjectTreeReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+	Filter  entities.ProjectFilter
+}
+
+// ProjectTreeResp output parameter from GetProjectTree
+type ProjectTreeResp struct {
+	Projects []entities.ProjectNode
+}
+
 // ProjectDetailsReq input parameter to GetProjectDetails
 type ProjectDetailsReq struct {
 	Context   ctxtg.Context
@@ -30,6 +43,30 @@ type ProjectDetailsResp struct {
 	Project entities.Project
 }
 
+// ProjectByIdentifierReq input parameter to GetProjectByIdentifier
+type ProjectByIdentifierReq struct {
+	Context    ctxtg.Context
+	Tracker    entities.Tracker
+	Identifier string
+}
+
+// ProjectByIdentifierResp output parameter from GetProjectByIdentifier
+type ProjectByIdentifierResp struct {
+	Project entities.Project
+}
+
+// GetProjectByURLReq input parameter to GetProjectByURL
+type GetProjectByURLReq struct {
+	Context    ctxtg.Context
+	Tracker    entities.Tracker
+	ProjectURL entities.ProjectURL
+}
+
+// GetProjectByURLResp output parameter from GetProjectByURL
+type GetProjectByURLResp struct {
+	Project entities.Project
+}
+
 // CurrentUserReq input parameter to GetCurrentUser
 type CurrentUserReq struct {
 	Context ctxtg.Context
@@ -41,11 +78,24 @@ type CurrentUserResp struct {
 	User entities.User
 }
 
+// APIKeyReq input parameter to ExchangeAPIKey
+type APIKeyReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+}
+
+// APIKeyResp output parameter from ExchangeAPIKey
+type APIKeyResp struct {
+	APIKey string
+}
+
 // ProjectIssuesReq input parameter to GetProjectIssues
 type ProjectIssuesReq struct {
 	Context   ctxtg.Context
 	Tracker   entities.Tracker
 	ProjectID entities.ProjectID
+	Filter    entities.IssueFilter
+	Sort      []entities.IssueSort
 	entities.Pagination
 }
 
@@ -89,6 +139,57 @@ type CreateReportReq struct {
 	Report    entities.Report
 }
 
+// CreateReportResp output parameter from CreateReport
+type CreateReportResp struct {
+	ID entities.ReportID
+}
+
+// ReportsReq input parameter to GetReports
+// From and To are UNIX timestamps (seconds) of first and last dates
+type ReportsReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+	From    int64
+	To      int64
+	entities.Pagination
+}
+
+// ReportsResp output parameter from GetReports
+type ReportsResp struct {
+	Reports []entities.Report
+	Amount  int64
+}
+
+// ReportsSummaryReq input parameter to GetReportsSummary
+// From and To are UNIX timestamps (seconds) of first and last dates
+type ReportsSummaryReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+	From    int64
+	To      int64
+}
+
+// ReportsSummaryResp output parameter from GetReportsSummary
+type ReportsSummaryResp struct {
+	Summary entities.ReportsSummary
+}
+
+// UpdateReportReq input parameter to UpdateReport
+type UpdateReportReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	Report    entities.Report
+}
+
+// DeleteReportReq input parameter to DeleteReport
+type DeleteReportReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	ReportID  entities.ReportID
+}
+
 // GetIssueByURLReq input parameter to GetIssueByURL
 type GetIssueByURLReq struct {
 	Context  ctxtg.Context
@@ -111,6 +212,71 @@ type UpdateIssueProgressReq struct {
 	Progress  entities.Progress
 }
 
+// UpdateIssueReq input parameter to UpdateIssue
+// Issue.Fields lists fields to change, fields with non zero value are changed when it is empty
+type UpdateIssueReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	Issue     entities.Issue
+}
+
+// UpdateIssueResp output parameter from UpdateIssue
+type UpdateIssueResp struct {
+	Issue entities.Issue
+}
+
+// CloseIssueReq input parameter to CloseIssue
+type CloseIssueReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	IssueID   entities.IssueID
+}
+
+// CloseIssueResp output parameter from CloseIssue
+type CloseIssueResp struct {
+	Issue entities.Issue
+}
+
+// DeleteIssueReq input parameter to DeleteIssue
+// Issue is deleted only when Confirm is set
+type DeleteIssueReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	IssueID   entities.IssueID
+	Confirm   bool
+}
+
+// MoveIssueReq input parameter to MoveIssue
+type MoveIssueReq struct {
+	Context         ctxtg.Context
+	Tracker         entities.Tracker
+	ProjectID       entities.ProjectID
+	IssueID         entities.IssueID
+	TargetProjectID entities.ProjectID
+}
+
+// MoveIssueResp output parameter from MoveIssue
+type MoveIssueResp struct {
+	Issue entities.Issue
+}
+
+// CopyIssueReq input parameter to CopyIssue
+type CopyIssueReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	IssueID   entities.IssueID
+	Copy      entities.IssueCopy
+}
+
+// CopyIssueResp output parameter from CopyIssue
+type CopyIssueResp struct {
+	Issue entities.Issue
+}
+
 // GetReportsReq input parameter to GetTotalReports
 type GetReportsReq struct {
 	Context ctxtg.Context
@@ -122,3 +288,246 @@ type GetReportsReq struct {
 type GetReportsResp struct {
 	Total int64
 }
+
+// IssueStatusesReq input parameter to GetIssueStatuses
+type IssueStatusesReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+}
+
+// IssueStatusesResp output parameter from GetIssueStatuses
+type IssueStatusesResp struct {
+	Statuses []entities.IssueStatus
+}
+
+// IssuePrioritiesReq input parameter to GetIssuePriorities
+type IssuePrioritiesReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+}
+
+// IssuePrioritiesResp output parameter from GetIssuePriorities
+type IssuePrioritiesResp struct {
+	Priorities []entities.IssuePriority
+}
+
+// IssueCategoriesReq input parameter to GetIssueCategories
+type IssueCategoriesReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+}
+
+// IssueCategoriesResp output parameter from GetIssueCategories
+type IssueCategoriesResp struct {
+	Categories []entities.IssueCategory
+}
+
+// UpdateIssueStatusReq input parameter to UpdateIssueStatus
+type UpdateIssueStatusReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	IssueID   entities.IssueID
+	ProjectID entities.ProjectID
+	StatusID  int64
+}
+
+// IssueCommentsReq input parameter to GetIssueComments
+type IssueCommentsReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	IssueID   entities.IssueID
+	ProjectID entities.ProjectID
+}
+
+// IssueCommentsResp output parameter from GetIssueComments
+type IssueCommentsResp struct {
+	Comments []entities.Comment
+}
+
+// AddIssueCommentReq input parameter to AddIssueComment
+type AddIssueCommentReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	IssueID   entities.IssueID
+	ProjectID entities.ProjectID
+	Comment   entities.Comment
+}
+
+// IssueAttachmentsReq input parameter to GetIssueAttachments
+type IssueAttachmentsReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	IssueID   entities.IssueID
+	ProjectID entities.ProjectID
+}
+
+// IssueAttachmentsResp output parameter from GetIssueAttachments
+type IssueAttachmentsResp struct {
+	Attachments []entities.Attachment
+}
+
+// AttachToIssueReq input parameter to AttachToIssue
+type AttachToIssueReq struct {
+	Context     ctxtg.Context
+	Tracker     entities.Tracker
+	IssueID     entities.IssueID
+	ProjectID   entities.ProjectID
+	Attachments []entities.NewAttachment
+}
+
+// DownloadAttachmentReq input parameter to DownloadAttachment
+type DownloadAttachmentReq struct {
+	Context      ctxtg.Context
+	Tracker      entities.Tracker
+	AttachmentID entities.AttachmentID
+}
+
+// DownloadAttachmentResp output parameter from DownloadAttachment
+type DownloadAttachmentResp struct {
+	Attachment entities.Attachment
+	Content    []byte
+}
+
+// IssueRelationsReq input parameter to GetIssueRelations
+type IssueRelationsReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	IssueID   entities.IssueID
+	ProjectID entities.ProjectID
+}
+
+// IssueRelationsResp output parameter from GetIssueRelations
+type IssueRelationsResp struct {
+	Relations []entities.Relation
+}
+
+// CreateRelationReq input parameter to CreateRelation
+type CreateRelationReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	Relation  entities.Relation
+}
+
+// CreateRelationResp output parameter from CreateRelation
+type CreateRelationResp struct {
+	Relation entities.Relation
+}
+
+// DeleteRelationReq input parameter to DeleteRelation
+type DeleteRelationReq struct {
+	Context    ctxtg.Context
+	Tracker    entities.Tracker
+	ProjectID  entities.ProjectID
+	RelationID entities.RelationID
+}
+
+// ProjectVersionsReq input parameter to GetProjectVersions
+type ProjectVersionsReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+}
+
+// ProjectVersionsResp output parameter from GetProjectVersions
+type ProjectVersionsResp struct {
+	Versions []entities.Version
+}
+
+// CreateVersionReq input parameter to CreateVersion
+type CreateVersionReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	Version   entities.Version
+}
+
+// CreateVersionResp output parameter from CreateVersion
+type CreateVersionResp struct {
+	Version entities.Version
+}
+
+// SavedQueriesReq input parameter to GetSavedQueries
+// Zero ProjectID returns queries of all projects
+type SavedQueriesReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+}
+
+// SavedQueriesResp output parameter from GetSavedQueries
+type SavedQueriesResp struct {
+	Queries []entities.SavedQuery
+}
+
+// SearchReq input parameter to Search
+type SearchReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+	Query   entities.SearchQuery
+	entities.Pagination
+}
+
+// SearchResp output parameter from Search
+type SearchResp struct {
+	Results []entities.SearchResult
+	Amount  int64
+}
+
+// IssueChangesReq input parameter to GetIssueChanges
+// Limit is 100 when zero or greater than 100
+type IssueChangesReq struct {
+	Context ctxtg.Context
+	Tracker entities.Tracker
+	Cursor  entities.IssueCursor
+	Limit   int
+}
+
+// IssueChangesResp output parameter from GetIssueChanges
+// Cursor should be passed to next GetIssueChanges call
+type IssueChangesResp struct {
+	Issues []entities.Issue
+	Cursor entities.IssueCursor
+}
+
+// WikiIndexReq input parameter to GetWikiIndex
+type WikiIndexReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+}
+
+// WikiIndexResp output parameter from GetWikiIndex
+type WikiIndexResp struct {
+	Pages []entities.WikiPageInfo
+}
+
+// WikiPageReq input parameter to GetWikiPage
+// Zero Version requests current version of page
+type WikiPageReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	Title     string
+	Version   int64
+}
+
+// WikiPageResp output parameter from GetWikiPage
+type WikiPageResp struct {
+	Page entities.WikiPage
+}
+
+// ProjectMembersReq input parameter to GetProjectMembers
+type ProjectMembersReq struct {
+	Context   ctxtg.Context
+	Tracker   entities.Tracker
+	ProjectID entities.ProjectID
+	entities.Pagination
+}
+
+// ProjectMembersResp output parameter from GetProjectMembers
+type ProjectMembersResp struct {
+	Members []entities.ProjectMember
+	Amount  int64
+}
@@ -2,7 +2,9 @@
 package rpcsvc
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"net/rpc"
 
@@ -28,18 +30,62 @@ func Init(r TrackerClient, p ctxtg.TokenParser) {
 // TrackerClient required interface for new tracker
 type TrackerClient interface {
 	Project(context.Context, entities.Tracker, entities.ProjectID) (*entities.Project, error)
-	//Projects return project list and total amount of projects
-	Projects(context.Context, entities.Tracker, entities.Pagination) ([]entities.Project, int64, error)
-	//ProjectIssues return issues assigned to user and total amount
-	ProjectIssues(context.Context, entities.Tracker, entities.ProjectID, entities.Pagination) ([]entities.Issue, int64, error)
+	ProjectByIdentifier(context.Context, entities.Tracker, string) (*entities.Project, error)
+	ProjectByURL(context.Context, entities.Tracker, entities.ProjectURL) (*entities.Project, error)
+	//Projects return project list matching filter and total amount of projects
+	Projects(context.Context, entities.Tracker, entities.ProjectFilter, entities.Pagination) ([]entities.Project, int64, error)
+	//ProjectTree return projects matching filter nested under parent projects
+	ProjectTree(context.Context, entities.Tracker, entities.ProjectFilter) ([]entities.ProjectNode, error)
+	//ProjectIssues return issues matching filter in sort order and total amount
+	ProjectIssues(context.Context, entities.Tracker, entities.ProjectID, entities.IssueFilter, []entities.IssueSort, entities.Pagination) ([]entities.Issue, int64, error)
 	UserInfo(context.Context, entities.Tracker) (*entities.User, error)
+	APIKey(context.Context, entities.Tracker) (string, error)
+	//IssueChanges return issues changed after cursor and cursor of last returned issue
+	IssueChanges(ctx context.Context, t entities.Tracker, c entities.IssueCursor, limit int) ([]entities.Issue, entities.IssueCursor, error)
 	Issue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) (*entities.Issue, error)
 	IssueByURL(context.Context, entities.Tracker, entities.IssueURL) (*entities.Issue, error)
 	CreateIssue(context.Context, entities.Tracker, entities.NewIssue, entities.ProjectID) (*entities.Issue, error)
 	UpdateIssueProgress(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.Progress) error
+	//UpdateIssue changes issue fields and return updated issue
+	UpdateIssue(context.Context, entities.Tracker, entities.ProjectID, entities.Issue) (*entities.Issue, error)
+	//CloseIssue moves issue to closed status and return updated issue
+	CloseIssue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) (*entities.Issue, error)
+	DeleteIssue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) error
+	//MoveIssue moves issue to target project and return updated issue
+	MoveIssue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.ProjectID) (*entities.Issue, error)
+	//CopyIssue creates copy of issue in another project and return created issue
+	CopyIssue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.IssueCopy) (*entities.Issue, error)
+	IssueStatuses(context.Context, entities.Tracker) ([]entities.IssueStatus, error)
+	IssuePriorities(context.Context, entities.Tracker) ([]entities.IssuePriority, error)
+	IssueCategories(context.Context, entities.Tracker, entities.ProjectID) ([]entities.IssueCategory, error)
+	UpdateIssueStatus(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, statusID int64) error
+	IssueComments(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Comment, error)
+	AddIssueComment(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.Comment) error
+	IssueAttachments(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Attachment, error)
+	AttachToIssue(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, []entities.NewAttachment) error
+	IssueRelations(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Relation, error)
+	CreateRelation(context.Context, entities.Tracker, entities.ProjectID, entities.Relation) (*entities.Relation, error)
+	DeleteRelation(context.Context, entities.Tracker, entities.ProjectID, entities.RelationID) error
+	//ProjectMembers return project members and total amount of them
+	ProjectMembers(context.Context, entities.Tracker, entities.ProjectID, entities.Pagination) ([]entities.ProjectMember, int64, error)
+	WikiIndex(context.Context, entities.Tracker, entities.ProjectID) ([]entities.WikiPageInfo, error)
+	WikiPage(ctx context.Context, t entities.Tracker, pid entities.ProjectID, title string, version int64) (*entities.WikiPage, error)
+	//Search return resources matching query and total amount
+	Search(context.Context, entities.Tracker, entities.SearchQuery, entities.Pagination) ([]entities.SearchResult, int64, error)
+	SavedQueries(context.Context, entities.Tracker, entities.ProjectID) ([]entities.SavedQuery, error)
+	ProjectVersions(context.Context, entities.Tracker, entities.ProjectID) ([]entities.Version, error)
+	CreateVersion(context.Context, entities.Tracker, entities.ProjectID, entities.Version) (*entities.Version, error)
+	//DownloadAttachment writes attachment content to writer and returns attachment info
+	DownloadAttachment(context.Context, entities.Tracker, entities.AttachmentID, io.Writer) (*entities.Attachment, error)
 	//TotalReports receive date as UNIX timestamp (seconds) and return total reported time at this day in seconds
 	TotalReports(ctx context.Context, t entities.Tracker, date int64) (int64, error)
-	CreateReport(context.Context, entities.Tracker, entities.ProjectID, entities.Report) error
+	//Reports receive dates range as UNIX timestamps (seconds) and return reports and total amount of them
+	Reports(ctx context.Context, t entities.Tracker, from, to int64, p entities.Pagination) ([]entities.Report, int64, error)
+	//ReportsSummary receive dates range as UNIX timestamps (seconds) and return reported time grouped by day, issue, project and activity
+	ReportsSummary(ctx context.Context, t entities.Tracker, from, to int64) (*entities.ReportsSummary, error)
+	CreateReport(context.Context, entities.Tracker, entities.ProjectID, entities.Report) (entities.ReportID, error)
+	UpdateReport(context.Context, entities.Tracker, entities.ProjectID, entities.Report) error
+	DeleteReport(context.Context, entities.Tracker, entities.ProjectID, entities.ReportID) error
 }
 
 func newAPI(r TrackerClient, p ctxtg.TokenParser) *API {
@@ -64,7 +110,7 @@ func (*API) Version(args *struct{}, res *string) error {
 // GetProjects return paginated projects list for user
 func (r *API) GetProjects(req *ProjectsReq, resp *ProjectsResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
-		projects, amount, err := r.tracker.Projects(ctx, req.Tracker, req.Pagination)
+		projects, amount, err := r.tracker.Projects(ctx, req.Tracker, req.Filter, req.Pagination)
 		*resp = ProjectsResp{
 			Projects: projects,
 			Amount:   amount,
@@ -74,6 +120,18 @@ func (r *API) GetProjects(req *ProjectsReq, resp *ProjectsResp) error {
 	return errWithLog(req.Context, "fail to GetProjects", err)
 }
 
+// GetProjectTree return projects of user nested under parent projects
+func (r *API) GetProjectTree(req *ProjectTreeReq, resp *ProjectTreeResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		projects, err := r.tracker.ProjectTree(ctx, req.Tracker, req.Filter)
+		*resp = ProjectTreeResp{
+			Projects: projects,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "fail to GetProjectTree", err)
+}
+
 // GetProjectDetails returns full information by projectID
 func (r *API) GetProjectDetails(req *ProjectDetailsReq, resp *ProjectDetailsResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
@@ -88,6 +146,34 @@ func (r *API) GetProjectDetails(req *ProjectDetailsReq, resp *ProjectDetailsResp
 	return errWithLog(req.Context, "fail to GetProjectDetails", err)
 }
 
+// GetProjectByIdentifier returns full information by project identifier
+func (r *API) GetProjectByIdentifier(req *ProjectByIdentifierReq, resp *ProjectByIdentifierResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		project, err := r.tracker.ProjectByIdentifier(ctx, req.Tracker, req.Identifier)
+		if project != nil {
+			*resp = ProjectByIdentifierResp{
+				Project: *project,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "fail to GetProjectByIdentifier", err)
+}
+
+// GetProjectByURL parse incoming URL and return project
+func (r *API) GetProjectByURL(req *GetProjectByURLReq, resp *GetProjectByURLResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		project, err := r.tracker.ProjectByURL(ctx, req.Tracker, req.ProjectURL)
+		if project != nil {
+			*resp = GetProjectByURLResp{
+				Project: *project,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "project by URL err", err)
+}
+
 // GetCurrentUser returns current user info
 func (r *API) GetCurrentUser(req *CurrentUserReq, resp *CurrentUserResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
@@ -102,10 +188,22 @@ func (r *API) GetCurrentUser(req *CurrentUserReq, resp *CurrentUserResp) error {
 	return errWithLog(req.Context, "current user info err", err)
 }
 
-// GetProjectIssues returns user's issues by project ID
+// ExchangeAPIKey returns API key of user, so clients can store it instead of password
+func (r *API) ExchangeAPIKey(req *APIKeyReq, resp *APIKeyResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		key, err := r.tracker.APIKey(ctx, req.Tracker)
+		*resp = APIKeyResp{
+			APIKey: key,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "api key exchange err", err)
+}
+
+// GetProjectIssues returns project issues matching filter, user's open issues by default
 func (r *API) GetProjectIssues(req *ProjectIssuesReq, resp *ProjectIssuesResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
-		is, amount, err := r.tracker.ProjectIssues(ctx, req.Tracker, req.ProjectID, req.Pagination)
+		is, amount, err := r.tracker.ProjectIssues(ctx, req.Tracker, req.ProjectID, req.Filter, req.Sort, req.Pagination)
 		*resp = ProjectIssuesResp{
 			Issues: is,
 			Amount: amount,
@@ -151,14 +249,354 @@ func (r *API) UpdateIssueProgress(req *UpdateIssueProgressReq, _ *struct{}) erro
 	return errWithLog(req.Context, "update issue err", err)
 }
 
-// CreateReport reports time on tracker for user ID
-func (r *API) CreateReport(req *CreateReportReq, _ *struct{}) error {
+// UpdateIssue changes issue fields and returns updated issue
+func (r *API) UpdateIssue(req *UpdateIssueReq, resp *UpdateIssueResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		issue, err := r.tracker.UpdateIssue(ctx, req.Tracker, req.ProjectID, req.Issue)
+		if issue != nil {
+			*resp = UpdateIssueResp{
+				Issue: *issue,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "update issue err", err)
+}
+
+// CloseIssue moves issue to closed status of project and returns updated issue
+func (r *API) CloseIssue(req *CloseIssueReq, resp *CloseIssueResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
-		return r.tracker.CreateReport(ctx, req.Tracker, req.ProjectID, req.Report)
+		issue, err := r.tracker.CloseIssue(ctx, req.Tracker, req.ProjectID, req.IssueID)
+		if issue != nil {
+			*resp = CloseIssueResp{
+				Issue: *issue,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "close issue err", err)
+}
+
+// DeleteIssue removes issue if deletion is confirmed
+func (r *API) DeleteIssue(req *DeleteIssueReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		if !req.Confirm {
+			return errors.Wrapf(entities.ErrNotConfirmed, "deletion of issue ID %d is not confirmed", req.IssueID)
+		}
+		return r.tracker.DeleteIssue(ctx, req.Tracker, req.ProjectID, req.IssueID)
+	})
+	return errWithLog(req.Context, "delete issue err", err)
+}
+
+// MoveIssue moves issue to target project and returns updated issue
+func (r *API) MoveIssue(req *MoveIssueReq, resp *MoveIssueResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		issue, err := r.tracker.MoveIssue(ctx, req.Tracker, req.ProjectID, req.IssueID, req.TargetProjectID)
+		if issue != nil {
+			*resp = MoveIssueResp{
+				Issue: *issue,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "move issue err", err)
+}
+
+// CopyIssue creates copy of issue in another project and returns created issue
+func (r *API) CopyIssue(req *CopyIssueReq, resp *CopyIssueResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		issue, err := r.tracker.CopyIssue(ctx, req.Tracker, req.ProjectID, req.IssueID, req.Copy)
+		if issue != nil {
+			*resp = CopyIssueResp{
+				Issue: *issue,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "copy issue err", err)
+}
+
+// GetIssueStatuses returns all issue statuses available on tracker
+func (r *API) GetIssueStatuses(req *IssueStatusesReq, resp *IssueStatusesResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		statuses, err := r.tracker.IssueStatuses(ctx, req.Tracker)
+		*resp = IssueStatusesResp{
+			Statuses: statuses,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue statuses err", err)
+}
+
+// GetIssuePriorities returns all issue priorities available on tracker
+func (r *API) GetIssuePriorities(req *IssuePrioritiesReq, resp *IssuePrioritiesResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		priorities, err := r.tracker.IssuePriorities(ctx, req.Tracker)
+		*resp = IssuePrioritiesResp{
+			Priorities: priorities,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue priorities err", err)
+}
+
+// GetIssueCategories returns issue categories of project
+func (r *API) GetIssueCategories(req *IssueCategoriesReq, resp *IssueCategoriesResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		categories, err := r.tracker.IssueCategories(ctx, req.Tracker, req.ProjectID)
+		*resp = IssueCategoriesResp{
+			Categories: categories,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue categories err", err)
+}
+
+// UpdateIssueStatus moves issue to another status
+func (r *API) UpdateIssueStatus(req *UpdateIssueStatusReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.UpdateIssueStatus(ctx, req.Tracker, req.ProjectID, req.IssueID, req.StatusID)
+	})
+	return errWithLog(req.Context, "update issue status err", err)
+}
+
+// GetIssueComments returns issue comments
+func (r *API) GetIssueComments(req *IssueCommentsReq, resp *IssueCommentsResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		comments, err := r.tracker.IssueComments(ctx, req.Tracker, req.ProjectID, req.IssueID)
+		*resp = IssueCommentsResp{
+			Comments: comments,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue comments err", err)
+}
+
+// AddIssueComment adds comment to issue
+func (r *API) AddIssueComment(req *AddIssueCommentReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.AddIssueComment(ctx, req.Tracker, req.ProjectID, req.IssueID, req.Comment)
+	})
+	return errWithLog(req.Context, "add issue comment err", err)
+}
+
+// GetIssueAttachments returns files attached to issue
+func (r *API) GetIssueAttachments(req *IssueAttachmentsReq, resp *IssueAttachmentsResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		as, err := r.tracker.IssueAttachments(ctx, req.Tracker, req.ProjectID, req.IssueID)
+		*resp = IssueAttachmentsResp{
+			Attachments: as,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue attachments err", err)
+}
+
+// AttachToIssue uploads files and attaches them to issue
+func (r *API) AttachToIssue(req *AttachToIssueReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.AttachToIssue(ctx, req.Tracker, req.ProjectID, req.IssueID, req.Attachments)
+	})
+	return errWithLog(req.Context, "attach to issue err", err)
+}
+
+// DownloadAttachment returns attachment info and its content
+func (r *API) DownloadAttachment(req *DownloadAttachmentReq, resp *DownloadAttachmentResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		var buf bytes.Buffer
+		a, err := r.tracker.DownloadAttachment(ctx, req.Tracker, req.AttachmentID, &buf)
+		if a != nil {
+			*resp = DownloadAttachmentResp{
+				Attachment: *a,
+				Content:    buf.Bytes(),
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "download attachment err", err)
+}
+
+// GetIssueRelations returns issue relations with other issues
+func (r *API) GetIssueRelations(req *IssueRelationsReq, resp *IssueRelationsResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		rs, err := r.tracker.IssueRelations(ctx, req.Tracker, req.ProjectID, req.IssueID)
+		*resp = IssueRelationsResp{
+			Relations: rs,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue relations err", err)
+}
+
+// CreateRelation links two issues
+func (r *API) CreateRelation(req *CreateRelationReq, resp *CreateRelationResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		rel, err := r.tracker.CreateRelation(ctx, req.Tracker, req.ProjectID, req.Relation)
+		if rel != nil {
+			*resp = CreateRelationResp{
+				Relation: *rel,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "create relation err", err)
+}
+
+// DeleteRelation removes link between two issues
+func (r *API) DeleteRelation(req *DeleteRelationReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.DeleteRelation(ctx, req.Tracker, req.ProjectID, req.RelationID)
+	})
+	return errWithLog(req.Context, "delete relation err", err)
+}
+
+// GetProjectMembers returns users and groups with their roles in project
+func (r *API) GetProjectMembers(req *ProjectMembersReq, resp *ProjectMembersResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		ms, amount, err := r.tracker.ProjectMembers(ctx, req.Tracker, req.ProjectID, req.Pagination)
+		*resp = ProjectMembersResp{
+			Members: ms,
+			Amount:  amount,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "project members err", err)
+}
+
+// GetWikiIndex returns list of project wiki pages
+func (r *API) GetWikiIndex(req *WikiIndexReq, resp *WikiIndexResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		ps, err := r.tracker.WikiIndex(ctx, req.Tracker, req.ProjectID)
+		*resp = WikiIndexResp{
+			Pages: ps,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "wiki index err", err)
+}
+
+// GetWikiPage returns project wiki page by title
+func (r *API) GetWikiPage(req *WikiPageReq, resp *WikiPageResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		page, err := r.tracker.WikiPage(ctx, req.Tracker, req.ProjectID, req.Title, req.Version)
+		if page != nil {
+			*resp = WikiPageResp{
+				Page: *page,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "wiki page err", err)
+}
+
+// Search performs full-text search on tracker
+func (r *API) Search(req *SearchReq, resp *SearchResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		rs, amount, err := r.tracker.Search(ctx, req.Tracker, req.Query, req.Pagination)
+		*resp = SearchResp{
+			Results: rs,
+			Amount:  amount,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "search err", err)
+}
+
+// GetSavedQueries returns issue queries saved on tracker,
+// ID of query can be used in GetProjectIssues filter
+func (r *API) GetSavedQueries(req *SavedQueriesReq, resp *SavedQueriesResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		qs, err := r.tracker.SavedQueries(ctx, req.Tracker, req.ProjectID)
+		*resp = SavedQueriesResp{
+			Queries: qs,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "saved queries err", err)
+}
+
+// GetProjectVersions returns project versions (milestones)
+func (r *API) GetProjectVersions(req *ProjectVersionsReq, resp *ProjectVersionsResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		vs, err := r.tracker.ProjectVersions(ctx, req.Tracker, req.ProjectID)
+		*resp = ProjectVersionsResp{
+			Versions: vs,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "project versions err", err)
+}
+
+// CreateVersion creates project version
+func (r *API) CreateVersion(req *CreateVersionReq, resp *CreateVersionResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		v, err := r.tracker.CreateVersion(ctx, req.Tracker, req.ProjectID, req.Version)
+		if v != nil {
+			*resp = CreateVersionResp{
+				Version: *v,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "create version err", err)
+}
+
+// CreateReport reports time on tracker for user ID and returns ID of created report
+func (r *API) CreateReport(req *CreateReportReq, resp *CreateReportResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		id, err := r.tracker.CreateReport(ctx, req.Tracker, req.ProjectID, req.Report)
+		*resp = CreateReportResp{
+			ID: id,
+		}
+		return err
 	})
 	return errWithLog(req.Context, "create report err", err)
 }
 
+// GetReports returns user reports between From and To dates
+func (r *API) GetReports(req *ReportsReq, resp *ReportsResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		rs, amount, err := r.tracker.Reports(ctx, req.Tracker, req.From, req.To, req.Pagination)
+		*resp = ReportsResp{
+			Reports: rs,
+			Amount:  amount,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "reports err", err)
+}
+
+// GetReportsSummary returns user reported time between From and To dates
+// grouped by day, issue, project and activity
+func (r *API) GetReportsSummary(req *ReportsSummaryReq, resp *ReportsSummaryResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		s, err := r.tracker.ReportsSummary(ctx, req.Tracker, req.From, req.To)
+		if s != nil {
+			*resp = ReportsSummaryResp{
+				Summary: *s,
+			}
+		}
+		return err
+	})
+	return errWithLog(req.Context, "reports summary err", err)
+}
+
+// UpdateReport updates existing report identified by Report.ID
+func (r *API) UpdateReport(req *UpdateReportReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.UpdateReport(ctx, req.Tracker, req.ProjectID, req.Report)
+	})
+	return errWithLog(req.Context, "update report err", err)
+}
+
+// DeleteReport deletes report by ID
+func (r *API) DeleteReport(req *DeleteReportReq, _ *struct{}) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		return r.tracker.DeleteReport(ctx, req.Tracker, req.ProjectID, req.ReportID)
+	})
+	return errWithLog(req.Context, "delete report err", err)
+}
+
 // GetTotalReports receive UNIX timestamp of date and aggregate reported time for user for this day
 func (r *API) GetTotalReports(req *GetReportsReq, resp *GetReportsResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
@@ -171,6 +609,20 @@ func (r *API) GetTotalReports(req *GetReportsReq, resp *GetReportsResp) error {
 	return errWithLog(req.Context, "total reports err", err)
 }
 
+// GetIssueChanges returns issues created or updated after cursor in order of update,
+// empty Issues means there are no changes since cursor
+func (r *API) GetIssueChanges(req *IssueChangesReq, resp *IssueChangesResp) error {
+	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
+		is, cursor, err := r.tracker.IssueChanges(ctx, req.Tracker, req.Cursor, req.Limit)
+		*resp = IssueChangesResp{
+			Issues: is,
+			Cursor: cursor,
+		}
+		return err
+	})
+	return errWithLog(req.Context, "issue changes err", err)
+}
+
 // GetIssueByURL parse incoming URL and return issue and project ID
 func (r *API) GetIssueByURL(req *GetIssueByURLReq, resp *GetIssueByURLResp) error {
 	err := r.tokenParser.ParseCtxWithClaims(req.Context, func(ctx context.Context, c ctxtg.Claims) error {
@@ -2,6 +2,7 @@ package rpcsvc
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"testing"
 
@@ -91,6 +92,7 @@ func TestProjectDetails(t *testing.T) {
 
 func TestGetProjects(t *testing.T) {
 	type test struct {
+		filter     entities.ProjectFilter
 		pagination entities.Pagination
 		projects   []entities.Project
 		amount     int64
@@ -134,11 +136,22 @@ func TestGetProjects(t *testing.T) {
 		"Empty project list": {
 			projects: []entities.Project{},
 		},
+		"Active only": {
+			filter: entities.ProjectFilter{ActiveOnly: true},
+			projects: []entities.Project{
+				{
+					ID:     1,
+					Title:  "t",
+					Status: entities.ProjectStatusActive,
+				},
+			},
+			amount: 1,
+		},
 	}
 
 	for label, test := range tests {
 		rc := TestRedmineClient{
-			projects: func(ctx context.Context, tr entities.Tracker, p entities.Pagination) ([]entities.Project, int64, error) {
+			projects: func(ctx context.Context, tr entities.Tracker, f entities.ProjectFilter, p entities.Pagination) ([]entities.Project, int64, error) {
 				if test.tokenErr != nil {
 					t.Error("Should not be called", label)
 				}
@@ -147,6 +160,9 @@ func TestGetProjects(t *testing.T) {
 				if p != test.pagination {
 					t.Errorf("Test %s invalid pagination", label)
 				}
+				if f != test.filter {
+					t.Errorf("Test %s invalid filter", label)
+				}
 				return test.projects, test.amount, test.err
 			},
 		}
@@ -160,6 +176,7 @@ func TestGetProjects(t *testing.T) {
 		err := r.GetProjects(&ProjectsReq{
 			Context:    testContext(test.token),
 			Tracker:    testTracker,
+			Filter:     test.filter,
 			Pagination: test.pagination,
 		}, &resp)
 
@@ -178,6 +195,74 @@ func TestGetProjects(t *testing.T) {
 	}
 }
 
+func TestGetProjectTree(t *testing.T) {
+	type test struct {
+		filter   entities.ProjectFilter
+		projects []entities.ProjectNode
+		token    ctxtg.Token
+		tokenErr error
+		err      error
+	}
+	tests := map[string]test{
+		"Return tree": {
+			filter: entities.ProjectFilter{ActiveOnly: true},
+			projects: []entities.ProjectNode{
+				{
+					Project: entities.Project{ID: 1, Title: "t"},
+					Children: []entities.ProjectNode{
+						{Project: entities.Project{ID: 2, ParentID: 1, Title: "t2"}},
+					},
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Return error": {
+			err: errors.New("hi"),
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			projectTree: func(ctx context.Context, tr entities.Tracker, f entities.ProjectFilter) ([]entities.ProjectNode, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if f != test.filter {
+					t.Errorf("Test %s invalid filter", label)
+				}
+				return test.projects, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp ProjectTreeResp
+		err := r.GetProjectTree(&ProjectTreeReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+			Filter:  test.filter,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.projects, resp.Projects) {
+			t.Errorf("Test %s unexpected projects resp", label)
+		}
+	}
+}
+
 func TestGetCurrentUser(t *testing.T) {
 	type test struct {
 		user     *entities.User
@@ -241,6 +326,8 @@ func TestGetCurrentUser(t *testing.T) {
 func TestGetProjectIssues(t *testing.T) {
 	type test struct {
 		issues     []entities.Issue
+		filter     entities.IssueFilter
+		sort       []entities.IssueSort
 		pagination entities.Pagination
 		amount     int64
 		err        error
@@ -261,6 +348,25 @@ func TestGetProjectIssues(t *testing.T) {
 			},
 			amount: 2,
 		},
+		"Filtered issues": {
+			issues: []entities.Issue{
+				{
+					ID:    3,
+					Title: "t3",
+				},
+			},
+			filter: entities.IssueFilter{
+				Status:     entities.IssueStatusAll,
+				TrackerIDs: []int64{1, 2},
+				Assignee:   entities.AssigneeAny,
+				Subject:    "crash",
+			},
+			sort: []entities.IssueSort{
+				{Field: "updated_on", Desc: true},
+			},
+			pagination: entities.Pagination{Offset: 10, Limit: 10},
+			amount:     11,
+		},
 		"Token parse error": {
 			token:    "invalid token",
 			tokenErr: ctxtg.ErrInvalidToken,
@@ -272,7 +378,7 @@ func TestGetProjectIssues(t *testing.T) {
 
 	for label, test := range tests {
 		rc := TestRedmineClient{
-			projectIssues: func(ctx context.Context, tr entities.Tracker, id entities.ProjectID, p entities.Pagination) ([]entities.Issue, int64, error) {
+			projectIssues: func(ctx context.Context, tr entities.Tracker, id entities.ProjectID, f entities.IssueFilter, s []entities.IssueSort, p entities.Pagination) ([]entities.Issue, int64, error) {
 				if test.tokenErr != nil {
 					t.Errorf("Should not be called %v", label)
 				}
@@ -281,6 +387,9 @@ func TestGetProjectIssues(t *testing.T) {
 				if p != test.pagination {
 					t.Errorf("Test %s invalid pagination", label)
 				}
+				if !reflect.DeepEqual(f, test.filter) || !reflect.DeepEqual(s, test.sort) {
+					t.Errorf("Test %s invalid filter or sort", label)
+				}
 				return test.issues, test.amount, test.err
 			},
 		}
@@ -293,8 +402,11 @@ func TestGetProjectIssues(t *testing.T) {
 
 		var resp ProjectIssuesResp
 		err := r.GetProjectIssues(&ProjectIssuesReq{
-			Context: testContext(test.token),
-			Tracker: testTracker,
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			Filter:     test.filter,
+			Sort:       test.sort,
+			Pagination: test.pagination,
 		}, &resp)
 
 		if err := p.Error(); err != nil {
@@ -312,6 +424,79 @@ func TestGetProjectIssues(t *testing.T) {
 	}
 }
 
+func TestGetIssueChanges(t *testing.T) {
+	type test struct {
+		cursor   entities.IssueCursor
+		limit    int
+		issues   []entities.Issue
+		next     entities.IssueCursor
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Changes": {
+			cursor: entities.IssueCursor{Updated: 1465553608, LastID: 5},
+			limit:  10,
+			issues: []entities.Issue{
+				{ID: 7, Title: "t", Updated: 1465553608},
+				{ID: 2, Title: "t2", Updated: 1465553618},
+			},
+			next: entities.IssueCursor{Updated: 1465553618, LastID: 2},
+		},
+		"No changes": {
+			cursor: entities.IssueCursor{Updated: 1465553608, LastID: 5},
+			next:   entities.IssueCursor{Updated: 1465553608, LastID: 5},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrRemoteServer,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueChanges: func(ctx context.Context, tr entities.Tracker, c entities.IssueCursor, limit int) ([]entities.Issue, entities.IssueCursor, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if c != test.cursor || limit != test.limit {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.issues, test.next, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueChangesResp
+		err := r.GetIssueChanges(&IssueChangesReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+			Cursor:  test.cursor,
+			Limit:   test.limit,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.issues, resp.Issues) || test.next != resp.Cursor {
+			t.Errorf("Test %s unexpected changes resp", label)
+		}
+	}
+}
+
 func TestCreateIssue(t *testing.T) {
 	type test struct {
 		issue         entities.NewIssue
@@ -356,7 +541,7 @@ func TestCreateIssue(t *testing.T) {
 				}
 				checkCtx(t, label, ctx)
 				checkTracker(t, label, tr)
-				if is != test.issue {
+				if !reflect.DeepEqual(is, test.issue) {
 					t.Errorf("Test %s invalid issue passed", label)
 				}
 				if id != test.projectID {
@@ -386,7 +571,7 @@ func TestCreateIssue(t *testing.T) {
 		if (test.err != nil || test.tokenErr != nil) && err == nil {
 			t.Errorf("Test %s should return err", label)
 		}
-		if test.issueToReturn != nil && *test.issueToReturn != resp.Issue {
+		if test.issueToReturn != nil && !reflect.DeepEqual(*test.issueToReturn, resp.Issue) {
 			t.Errorf("Test %s invalid issue returned", label)
 		}
 	}
@@ -456,7 +641,7 @@ func TestGetIssue(t *testing.T) {
 		if (test.err != nil || test.tokenErr != nil) && err == nil {
 			t.Errorf("Test %s should return err", label)
 		}
-		if test.issue != nil && *test.issue != resp.Issue {
+		if test.issue != nil && !reflect.DeepEqual(*test.issue, resp.Issue) {
 			t.Errorf("Test %s invalid issue returned", label)
 		}
 	}
@@ -523,12 +708,146 @@ func TestGetIssueByURL(t *testing.T) {
 		if (test.err != nil || test.tokenErr != nil) && err == nil {
 			t.Errorf("Test %s should return err", label)
 		}
-		if test.issue != nil && *test.issue != resp.Issue && test.issue.ProjectID != resp.ProjectID {
+		if test.issue != nil && !reflect.DeepEqual(*test.issue, resp.Issue) && test.issue.ProjectID != resp.ProjectID {
 			t.Errorf("Test %s invalid issue returned", label)
 		}
 	}
 }
 
+func TestGetProjectByURL(t *testing.T) {
+	type test struct {
+		project    *entities.Project
+		projectURL entities.ProjectURL
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Ok project": {
+			project: &entities.Project{
+				ID:         2,
+				Identifier: "mobile-app",
+			},
+			projectURL: "/projects/mobile-app/issues",
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			projectURL: "/issues/1",
+			err:        entities.ErrProjectURL,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			projectByURL: func(ctx context.Context, tr entities.Tracker, url entities.ProjectURL) (*entities.Project, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if url != test.projectURL {
+					t.Errorf("Test %s invalid project URL passed", label)
+				}
+				return test.project, test.err
+			},
+		}
+
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+
+		var resp GetProjectByURLResp
+		err := r.GetProjectByURL(&GetProjectByURLReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			ProjectURL: test.projectURL,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.project != nil && !reflect.DeepEqual(*test.project, resp.Project) {
+			t.Errorf("Test %s invalid project returned", label)
+		}
+	}
+}
+
+func TestGetProjectByIdentifier(t *testing.T) {
+	type test struct {
+		project    *entities.Project
+		identifier string
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Ok project": {
+			project: &entities.Project{
+				ID:         2,
+				Identifier: "mobile-app",
+			},
+			identifier: "mobile-app",
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Not found": {
+			identifier: "unknown",
+			err:        entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			projectByIdentifier: func(ctx context.Context, tr entities.Tracker, identifier string) (*entities.Project, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if identifier != test.identifier {
+					t.Errorf("Test %s invalid identifier passed", label)
+				}
+				return test.project, test.err
+			},
+		}
+
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+
+		var resp ProjectByIdentifierResp
+		err := r.GetProjectByIdentifier(&ProjectByIdentifierReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			Identifier: test.identifier,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.project != nil && !reflect.DeepEqual(*test.project, resp.Project) {
+			t.Errorf("Test %s invalid project returned", label)
+		}
+	}
+}
+
 func TestUpdateIssue(t *testing.T) {
 	type test struct {
 		progress      entities.Progress
@@ -601,6 +920,7 @@ func TestCreateReport(t *testing.T) {
 	type test struct {
 		report    entities.Report
 		projectID entities.ProjectID
+		reportID  entities.ReportID
 		err       error
 		token     ctxtg.Token
 		tokenErr  error
@@ -615,6 +935,7 @@ func TestCreateReport(t *testing.T) {
 				Duration:   4,
 				Started:    5,
 			},
+			reportID: 6,
 		},
 		"Token parse error": {
 			token:    "invalid token",
@@ -627,7 +948,7 @@ func TestCreateReport(t *testing.T) {
 
 	for label, test := range tests {
 		rc := TestRedmineClient{
-			createReport: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, rep entities.Report) error {
+			createReport: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, rep entities.Report) (entities.ReportID, error) {
 				if test.tokenErr != nil {
 					t.Error("Should not be called", label)
 				}
@@ -636,10 +957,10 @@ func TestCreateReport(t *testing.T) {
 				}
 				checkCtx(t, label, ctx)
 				checkTracker(t, label, tr)
-				if rep != test.report {
+				if !reflect.DeepEqual(rep, test.report) {
 					t.Errorf("Test %s invalid report passed", label)
 				}
-				return test.err
+				return test.reportID, test.err
 			},
 		}
 		p := &ctxtgtest.Parser{
@@ -649,12 +970,13 @@ func TestCreateReport(t *testing.T) {
 
 		r := newAPI(rc, p)
 
+		var resp CreateReportResp
 		err := r.CreateReport(&CreateReportReq{
 			Context:   testContext(test.token),
 			Tracker:   testTracker,
 			ProjectID: test.projectID,
 			Report:    test.report,
-		}, &struct{}{})
+		}, &resp)
 
 		if err := p.Error(); err != nil {
 			t.Errorf("Parser error in test %v: %v", label, err)
@@ -662,6 +984,9 @@ func TestCreateReport(t *testing.T) {
 		if (test.err != nil || test.tokenErr != nil) && err == nil {
 			t.Errorf("Test %s should return err", label)
 		}
+		if resp.ID != test.reportID {
+			t.Errorf("Test %s unexpected report ID %d", label, resp.ID)
+		}
 	}
 }
 
@@ -726,57 +1051,2045 @@ func TestGetTotalReports(t *testing.T) {
 	}
 }
 
-func checkCtx(t *testing.T, label string, ctx context.Context) {
-	if ctx == nil {
-		t.Errorf("Test %s passed nil context", label)
+func TestGetIssuePriorities(t *testing.T) {
+	type test struct {
+		priorities []entities.IssuePriority
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
 	}
-}
-
-func checkTracker(t *testing.T, label string, tr entities.Tracker) {
-	if tr != testTracker {
-		t.Errorf("Test %s Invalid tracker passed", label)
+	tests := map[string]test{
+		"Priorities": {
+			priorities: []entities.IssuePriority{
+				{
+					ID:   3,
+					Name: "Low",
+				},
+				{
+					ID:        4,
+					Name:      "Normal",
+					IsDefault: true,
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrRemoteServer,
+		},
 	}
-}
-
-var testTracker = entities.Tracker{
-	ID:   30,
-	URL:  "testurl",
-	Type: "RED",
-	Credentials: entities.Credentials{
-		Login:    "str",
-		Password: "pass",
-	},
-}
 
-func testContext(token ctxtg.Token) ctxtg.Context {
-	return ctxtg.Context{
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issuePriorities: func(ctx context.Context, tr entities.Tracker) ([]entities.IssuePriority, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				return test.priorities, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssuePrioritiesResp
+		err := r.GetIssuePriorities(&IssuePrioritiesReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.priorities, resp.Priorities) {
+			t.Errorf("Test %s unexpected priorities %+v", label, resp.Priorities)
+		}
+	}
+}
+
+func TestGetIssueCategories(t *testing.T) {
+	type test struct {
+		categories []entities.IssueCategory
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Categories": {
+			categories: []entities.IssueCategory{
+				{
+					ID:        12,
+					ProjectID: 223,
+					Name:      "Backend",
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueCategories: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID) ([]entities.IssueCategory, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 223 {
+					t.Errorf("Test %s invalid project ID %d", label, pid)
+				}
+				return test.categories, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueCategoriesResp
+		err := r.GetIssueCategories(&IssueCategoriesReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 223,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.categories, resp.Categories) {
+			t.Errorf("Test %s unexpected categories %+v", label, resp.Categories)
+		}
+	}
+}
+
+func TestMoveIssue(t *testing.T) {
+	type test struct {
+		moved    *entities.Issue
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Move issue": {
+			moved: &entities.Issue{
+				ID:        1,
+				ProjectID: 3,
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Project not found": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			moveIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID, target entities.ProjectID) (*entities.Issue, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 2 || iid != 1 || target != 3 {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.moved, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp MoveIssueResp
+		err := r.MoveIssue(&MoveIssueReq{
+			Context:         testContext(test.token),
+			Tracker:         testTracker,
+			ProjectID:       2,
+			IssueID:         1,
+			TargetProjectID: 3,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.moved != nil && !reflect.DeepEqual(*test.moved, resp.Issue) {
+			t.Errorf("Test %s invalid issue returned", label)
+		}
+	}
+}
+
+func TestCopyIssue(t *testing.T) {
+	type test struct {
+		copied   *entities.Issue
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Copy issue": {
+			copied: &entities.Issue{
+				ID:        10,
+				ProjectID: 3,
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Issue not found": {
+			err: entities.ErrIssueNotFound,
+		},
+	}
+	testCopy := entities.IssueCopy{
+		ProjectID:   3,
+		Subtasks:    true,
+		Attachments: true,
+		Link:        true,
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			copyIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID, c entities.IssueCopy) (*entities.Issue, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 2 || iid != 1 || c != testCopy {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.copied, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp CopyIssueResp
+		err := r.CopyIssue(&CopyIssueReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 2,
+			IssueID:   1,
+			Copy:      testCopy,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.copied != nil && !reflect.DeepEqual(*test.copied, resp.Issue) {
+			t.Errorf("Test %s invalid issue returned", label)
+		}
+	}
+}
+
+func TestGetIssueStatuses(t *testing.T) {
+	type test struct {
+		statuses []entities.IssueStatus
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Statuses": {
+			statuses: []entities.IssueStatus{
+				{
+					ID:   1,
+					Name: "New",
+				},
+				{
+					ID:       5,
+					Name:     "Closed",
+					IsClosed: true,
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrRemoteServer,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueStatuses: func(ctx context.Context, tr entities.Tracker) ([]entities.IssueStatus, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				return test.statuses, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueStatusesResp
+		err := r.GetIssueStatuses(&IssueStatusesReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.statuses, resp.Statuses) {
+			t.Errorf("Test %s unexpected statuses resp", label)
+		}
+	}
+}
+
+func TestUpdateIssueStatus(t *testing.T) {
+	type test struct {
+		issueID   entities.IssueID
+		projectID entities.ProjectID
+		statusID  int64
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Update status": {
+			issueID:   1,
+			projectID: 2,
+			statusID:  3,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			statusID: 3,
+			err:      entities.ErrForbidden,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			updateIssueStatus: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID, sid int64) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID || sid != test.statusID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.UpdateIssueStatus(&UpdateIssueStatusReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			IssueID:   test.issueID,
+			ProjectID: test.projectID,
+			StatusID:  test.statusID,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestUpdateIssueFields(t *testing.T) {
+	type test struct {
+		issue    entities.Issue
+		updated  *entities.Issue
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Update issue": {
+			issue: entities.Issue{
+				ID:     1,
+				Fields: []entities.IssueField{entities.IssueFieldDueDate},
+			},
+			updated: &entities.Issue{
+				ID:    1,
+				Title: "issue1",
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			issue: entities.Issue{ID: 1},
+			err:   entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			updateIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, i entities.Issue) (*entities.Issue, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 2 || !reflect.DeepEqual(i, test.issue) {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.updated, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp UpdateIssueResp
+		err := r.UpdateIssue(&UpdateIssueReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 2,
+			Issue:     test.issue,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.updated != nil && !reflect.DeepEqual(*test.updated, resp.Issue) {
+			t.Errorf("Test %s invalid issue returned", label)
+		}
+	}
+}
+
+func TestCloseIssue(t *testing.T) {
+	type test struct {
+		closed   *entities.Issue
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Close issue": {
+			closed: &entities.Issue{
+				ID:     1,
+				Status: entities.TypeID{ID: 5, Name: "Closed"},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"No closed status": {
+			err: entities.ErrNoClosedStatus,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			closeIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID) (*entities.Issue, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 2 || iid != 1 {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.closed, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp CloseIssueResp
+		err := r.CloseIssue(&CloseIssueReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 2,
+			IssueID:   1,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.closed != nil && !reflect.DeepEqual(*test.closed, resp.Issue) {
+			t.Errorf("Test %s invalid issue returned", label)
+		}
+	}
+}
+
+func TestDeleteIssue(t *testing.T) {
+	type test struct {
+		confirm  bool
+		called   bool
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Delete issue": {
+			confirm: true,
+			called:  true,
+		},
+		"Not confirmed": {
+			err: entities.ErrNotConfirmed,
+		},
+		"Token parse error": {
+			confirm:  true,
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			confirm: true,
+			called:  true,
+			err:     entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		var called bool
+		rc := TestRedmineClient{
+			deleteIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID) error {
+				called = true
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 2 || iid != 1 {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.DeleteIssue(&DeleteIssueReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 2,
+			IssueID:   1,
+			Confirm:   test.confirm,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if called != test.called {
+			t.Errorf("Test %s unexpected tracker call", label)
+		}
+		if test.err != nil && err != test.err {
+			t.Errorf("Test %s unexpected err %v", label, err)
+		}
+		if test.tokenErr != nil && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestGetIssueComments(t *testing.T) {
+	type test struct {
+		issueID   entities.IssueID
+		projectID entities.ProjectID
+		comments  []entities.Comment
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Comments": {
+			issueID:   1,
+			projectID: 2,
+			comments: []entities.Comment{
+				{
+					ID:   1,
+					Text: "first",
+				},
+				{
+					ID:      2,
+					Text:    "second",
+					Private: true,
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueComments: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID) ([]entities.Comment, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.comments, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueCommentsResp
+		err := r.GetIssueComments(&IssueCommentsReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			IssueID:   test.issueID,
+			ProjectID: test.projectID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.comments, resp.Comments) {
+			t.Errorf("Test %s unexpected comments resp", label)
+		}
+	}
+}
+
+func TestAddIssueComment(t *testing.T) {
+	type test struct {
+		issueID   entities.IssueID
+		projectID entities.ProjectID
+		comment   entities.Comment
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Add comment": {
+			issueID:   1,
+			projectID: 2,
+			comment: entities.Comment{
+				Text:    "Done with API part",
+				Private: true,
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrForbidden,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			addIssueComment: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID, c entities.Comment) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID || c != test.comment {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.AddIssueComment(&AddIssueCommentReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			IssueID:   test.issueID,
+			ProjectID: test.projectID,
+			Comment:   test.comment,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestGetIssueAttachments(t *testing.T) {
+	type test struct {
+		issueID     entities.IssueID
+		projectID   entities.ProjectID
+		attachments []entities.Attachment
+		err         error
+		token       ctxtg.Token
+		tokenErr    error
+	}
+	tests := map[string]test{
+		"Attachments": {
+			issueID:   1,
+			projectID: 2,
+			attachments: []entities.Attachment{
+				{
+					ID:       1,
+					Filename: "screenshot.png",
+					Size:     1024,
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueAttachments: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID) ([]entities.Attachment, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.attachments, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueAttachmentsResp
+		err := r.GetIssueAttachments(&IssueAttachmentsReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			IssueID:   test.issueID,
+			ProjectID: test.projectID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.attachments, resp.Attachments) {
+			t.Errorf("Test %s unexpected attachments resp", label)
+		}
+	}
+}
+
+func TestAttachToIssue(t *testing.T) {
+	type test struct {
+		issueID     entities.IssueID
+		projectID   entities.ProjectID
+		attachments []entities.NewAttachment
+		err         error
+		token       ctxtg.Token
+		tokenErr    error
+	}
+	tests := map[string]test{
+		"Attach": {
+			issueID:   1,
+			projectID: 2,
+			attachments: []entities.NewAttachment{
+				{
+					Filename:    "screenshot.png",
+					ContentType: "image/png",
+					Content:     []byte{1, 2, 3},
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrForbidden,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			attachToIssue: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID, as []entities.NewAttachment) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID || !reflect.DeepEqual(as, test.attachments) {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.AttachToIssue(&AttachToIssueReq{
+			Context:     testContext(test.token),
+			Tracker:     testTracker,
+			IssueID:     test.issueID,
+			ProjectID:   test.projectID,
+			Attachments: test.attachments,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	type test struct {
+		attachmentID entities.AttachmentID
+		attachment   *entities.Attachment
+		content      []byte
+		err          error
+		token        ctxtg.Token
+		tokenErr     error
+	}
+	tests := map[string]test{
+		"Download": {
+			attachmentID: 5,
+			attachment: &entities.Attachment{
+				ID:       5,
+				Filename: "screenshot.png",
+				Size:     3,
+			},
+			content: []byte{1, 2, 3},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrAttachmentNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			downloadAttachment: func(ctx context.Context, tr entities.Tracker, id entities.AttachmentID, w io.Writer) (*entities.Attachment, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if id != test.attachmentID {
+					t.Errorf("Test %s invalid attachment ID passed", label)
+				}
+				w.Write(test.content)
+				return test.attachment, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp DownloadAttachmentResp
+		err := r.DownloadAttachment(&DownloadAttachmentReq{
+			Context:      testContext(test.token),
+			Tracker:      testTracker,
+			AttachmentID: test.attachmentID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.attachment != nil && (*test.attachment != resp.Attachment || !reflect.DeepEqual(test.content, resp.Content)) {
+			t.Errorf("Test %s unexpected attachment resp", label)
+		}
+	}
+}
+
+func TestGetIssueRelations(t *testing.T) {
+	type test struct {
+		issueID   entities.IssueID
+		projectID entities.ProjectID
+		relations []entities.Relation
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Relations": {
+			issueID:   1,
+			projectID: 2,
+			relations: []entities.Relation{
+				{
+					ID:        10,
+					IssueID:   3,
+					IssueToID: 1,
+					Type:      "blocks",
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			issueRelations: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, iid entities.IssueID) ([]entities.Relation, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || iid != test.issueID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.relations, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp IssueRelationsResp
+		err := r.GetIssueRelations(&IssueRelationsReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			IssueID:   test.issueID,
+			ProjectID: test.projectID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.relations, resp.Relations) {
+			t.Errorf("Test %s unexpected relations resp", label)
+		}
+	}
+}
+
+func TestCreateRelation(t *testing.T) {
+	type test struct {
+		projectID        entities.ProjectID
+		relation         entities.Relation
+		relationToReturn *entities.Relation
+		err              error
+		token            ctxtg.Token
+		tokenErr         error
+	}
+	tests := map[string]test{
+		"Create relation": {
+			projectID: 2,
+			relation: entities.Relation{
+				IssueID:   1,
+				IssueToID: 3,
+				Type:      "precedes",
+				Delay:     2,
+			},
+			relationToReturn: &entities.Relation{
+				ID:        10,
+				IssueID:   1,
+				IssueToID: 3,
+				Type:      "precedes",
+				Delay:     2,
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrIssueNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			createRelation: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, rel entities.Relation) (*entities.Relation, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || rel != test.relation {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.relationToReturn, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp CreateRelationResp
+		err := r.CreateRelation(&CreateRelationReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+			Relation:  test.relation,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.relationToReturn != nil && *test.relationToReturn != resp.Relation {
+			t.Errorf("Test %s invalid relation returned", label)
+		}
+	}
+}
+
+func TestDeleteRelation(t *testing.T) {
+	type test struct {
+		projectID  entities.ProjectID
+		relationID entities.RelationID
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Delete relation": {
+			projectID:  2,
+			relationID: 10,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrRelationNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			deleteRelation: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, id entities.RelationID) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || id != test.relationID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.DeleteRelation(&DeleteRelationReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			ProjectID:  test.projectID,
+			RelationID: test.relationID,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	type test struct {
+		query      entities.SearchQuery
+		pagination entities.Pagination
+		results    []entities.SearchResult
+		amount     int64
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Results": {
+			query: entities.SearchQuery{
+				Text:  "adapter",
+				Types: []string{entities.SearchIssues},
+			},
+			pagination: entities.Pagination{Limit: 10},
+			results: []entities.SearchResult{
+				{ID: 1, Type: "issue", Title: "Task #1: adapter", URL: "http://redmine/issues/1"},
+			},
+			amount: 1,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrSearchQuery,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			search: func(ctx context.Context, tr entities.Tracker, s entities.SearchQuery, p entities.Pagination) ([]entities.SearchResult, int64, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if !reflect.DeepEqual(s, test.query) || p != test.pagination {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.results, test.amount, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp SearchResp
+		err := r.Search(&SearchReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			Query:      test.query,
+			Pagination: test.pagination,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.results, resp.Results) || test.amount != resp.Amount {
+			t.Errorf("Test %s unexpected search resp", label)
+		}
+	}
+}
+
+func TestExchangeAPIKey(t *testing.T) {
+	type test struct {
+		key      string
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Return key": {
+			key: "620a6dd5aabab4ce74e0e752d85e99163ffac8e4",
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrCredentials,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			apiKey: func(ctx context.Context, tr entities.Tracker) (string, error) {
+				if test.tokenErr != nil {
+					t.Errorf("Should not be called %v", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				return test.key, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp APIKeyResp
+		err := r.ExchangeAPIKey(&APIKeyReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.key != resp.APIKey {
+			t.Errorf("Test %s invalid key returned %s", label, resp.APIKey)
+		}
+	}
+}
+
+func TestGetProjectMembers(t *testing.T) {
+	type test struct {
+		pagination entities.Pagination
+		members    []entities.ProjectMember
+		amount     int64
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Members": {
+			pagination: entities.Pagination{Limit: 10},
+			members: []entities.ProjectMember{
+				{ID: 1, User: entities.TypeID{ID: 1131, Name: "Anatolii"}, Roles: []entities.TypeID{{ID: 4, Name: "Developer"}}},
+				{ID: 2, User: entities.TypeID{ID: 77, Name: "QA team"}, IsGroup: true},
+			},
+			amount: 2,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			projectMembers: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, p entities.Pagination) ([]entities.ProjectMember, int64, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 223 || p != test.pagination {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.members, test.amount, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp ProjectMembersResp
+		err := r.GetProjectMembers(&ProjectMembersReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			ProjectID:  223,
+			Pagination: test.pagination,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.members, resp.Members) || test.amount != resp.Amount {
+			t.Errorf("Test %s unexpected members resp", label)
+		}
+	}
+}
+
+func TestGetWikiIndex(t *testing.T) {
+	type test struct {
+		pages    []entities.WikiPageInfo
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Pages": {
+			pages: []entities.WikiPageInfo{
+				{Title: "Wiki", Version: 4},
+				{Title: "Rest_client", Parent: "Wiki", Version: 2},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			wikiIndex: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID) ([]entities.WikiPageInfo, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 223 {
+					t.Errorf("Test %s invalid project ID %d", label, pid)
+				}
+				return test.pages, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp WikiIndexResp
+		err := r.GetWikiIndex(&WikiIndexReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 223,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.pages, resp.Pages) {
+			t.Errorf("Test %s unexpected pages %+v", label, resp.Pages)
+		}
+	}
+}
+
+func TestGetWikiPage(t *testing.T) {
+	type test struct {
+		title    string
+		version  int64
+		page     *entities.WikiPage
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Current version": {
+			title: "Rest_client",
+			page: &entities.WikiPage{
+				WikiPageInfo: entities.WikiPageInfo{Title: "Rest_client", Version: 2},
+				Text:         "Rest client configuration",
+			},
+		},
+		"Old version": {
+			title:   "Rest_client",
+			version: 1,
+			page: &entities.WikiPage{
+				WikiPageInfo: entities.WikiPageInfo{Title: "Rest_client", Version: 1},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Not found": {
+			title: "Missing",
+			err:   entities.ErrWikiPageNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			wikiPage: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, title string, version int64) (*entities.WikiPage, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != 223 || title != test.title || version != test.version {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.page, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp WikiPageResp
+		err := r.GetWikiPage(&WikiPageReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: 223,
+			Title:     test.title,
+			Version:   test.version,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.page != nil && !reflect.DeepEqual(*test.page, resp.Page) {
+			t.Errorf("Test %s unexpected page %+v", label, resp.Page)
+		}
+	}
+}
+
+func TestGetSavedQueries(t *testing.T) {
+	type test struct {
+		projectID entities.ProjectID
+		queries   []entities.SavedQuery
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Queries": {
+			projectID: 2,
+			queries: []entities.SavedQuery{
+				{ID: 1, Name: "This sprint", Public: true, ProjectID: 2},
+				{ID: 3, Name: "Needs estimate"},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrForbidden,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			savedQueries: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID) ([]entities.SavedQuery, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.queries, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp SavedQueriesResp
+		err := r.GetSavedQueries(&SavedQueriesReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.queries, resp.Queries) {
+			t.Errorf("Test %s unexpected queries resp", label)
+		}
+	}
+}
+
+func TestGetProjectVersions(t *testing.T) {
+	type test struct {
+		projectID entities.ProjectID
+		versions  []entities.Version
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Versions": {
+			projectID: 2,
+			versions: []entities.Version{
+				{
+					ID:        10,
+					ProjectID: 2,
+					Name:      "1.0",
+					Status:    "open",
+					Sharing:   "none",
+					DueDate:   1483142400,
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			projectVersions: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID) ([]entities.Version, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.versions, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp ProjectVersionsResp
+		err := r.GetProjectVersions(&ProjectVersionsReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.versions, resp.Versions) {
+			t.Errorf("Test %s unexpected versions resp", label)
+		}
+	}
+}
+
+func TestCreateVersion(t *testing.T) {
+	type test struct {
+		projectID       entities.ProjectID
+		version         entities.Version
+		versionToReturn *entities.Version
+		err             error
+		token           ctxtg.Token
+		tokenErr        error
+	}
+	tests := map[string]test{
+		"Create version": {
+			projectID: 2,
+			version: entities.Version{
+				Name:    "1.1",
+				Sharing: "descendants",
+			},
+			versionToReturn: &entities.Version{
+				ID:        11,
+				ProjectID: 2,
+				Name:      "1.1",
+				Status:    "open",
+				Sharing:   "descendants",
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrProjectNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			createVersion: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, v entities.Version) (*entities.Version, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || v != test.version {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.versionToReturn, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp CreateVersionResp
+		err := r.CreateVersion(&CreateVersionReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+			Version:   test.version,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.versionToReturn != nil && *test.versionToReturn != resp.Version {
+			t.Errorf("Test %s invalid version returned", label)
+		}
+	}
+}
+
+func TestGetReports(t *testing.T) {
+	type test struct {
+		from       int64
+		to         int64
+		pagination entities.Pagination
+		reports    []entities.Report
+		amount     int64
+		err        error
+		token      ctxtg.Token
+		tokenErr   error
+	}
+	tests := map[string]test{
+		"Reports": {
+			from:       1465776000,
+			to:         1465862400,
+			pagination: entities.Pagination{Offset: 10, Limit: 5},
+			reports: []entities.Report{
+				{
+					ID:         1,
+					ProjectID:  2,
+					IssueID:    3,
+					ActivityID: 4,
+					Duration:   3600,
+					Started:    1465862400,
+				},
+			},
+			amount: 11,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrTrackerURL,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			reports: func(ctx context.Context, tr entities.Tracker, from, to int64, pg entities.Pagination) ([]entities.Report, int64, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if from != test.from || to != test.to || pg != test.pagination {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.reports, test.amount, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp ReportsResp
+		err := r.GetReports(&ReportsReq{
+			Context:    testContext(test.token),
+			Tracker:    testTracker,
+			From:       test.from,
+			To:         test.to,
+			Pagination: test.pagination,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if !reflect.DeepEqual(test.reports, resp.Reports) || test.amount != resp.Amount {
+			t.Errorf("Test %s unexpected reports resp", label)
+		}
+	}
+}
+
+func TestGetReportsSummary(t *testing.T) {
+	type test struct {
+		from     int64
+		to       int64
+		summary  *entities.ReportsSummary
+		err      error
+		token    ctxtg.Token
+		tokenErr error
+	}
+	tests := map[string]test{
+		"Summary": {
+			from: 1465776000,
+			to:   1465862400,
+			summary: &entities.ReportsSummary{
+				Total: 3600,
+				ByDay: []entities.ReportsTotal{
+					{ID: 1465776000, Name: "2016-06-13", Duration: 3600},
+				},
+				ByIssue: []entities.ReportsTotal{
+					{ID: 1, Duration: 3600},
+				},
+				ByProject: []entities.ReportsTotal{
+					{ID: 2, Name: "Project", Duration: 3600},
+				},
+				ByActivity: []entities.ReportsTotal{
+					{ID: 3, Name: "Development", Duration: 3600},
+				},
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error": {
+			err: entities.ErrTrackerURL,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			reportsSummary: func(ctx context.Context, tr entities.Tracker, from, to int64) (*entities.ReportsSummary, error) {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if from != test.from || to != test.to {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.summary, test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		var resp ReportsSummaryResp
+		err := r.GetReportsSummary(&ReportsSummaryReq{
+			Context: testContext(test.token),
+			Tracker: testTracker,
+			From:    test.from,
+			To:      test.to,
+		}, &resp)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+		if test.summary != nil && !reflect.DeepEqual(*test.summary, resp.Summary) {
+			t.Errorf("Test %s unexpected summary resp", label)
+		}
+	}
+}
+
+func TestUpdateReport(t *testing.T) {
+	type test struct {
+		projectID entities.ProjectID
+		report    entities.Report
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Update report": {
+			projectID: 2,
+			report: entities.Report{
+				ID:         1,
+				IssueID:    3,
+				ActivityID: 4,
+				Duration:   5400,
+				Started:    1465862400,
+			},
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrReportNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			updateReport: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, rep entities.Report) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || !reflect.DeepEqual(rep, test.report) {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.UpdateReport(&UpdateReportReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+			Report:    test.report,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func TestDeleteReport(t *testing.T) {
+	type test struct {
+		projectID entities.ProjectID
+		reportID  entities.ReportID
+		err       error
+		token     ctxtg.Token
+		tokenErr  error
+	}
+	tests := map[string]test{
+		"Delete report": {
+			projectID: 2,
+			reportID:  10,
+		},
+		"Token parse error": {
+			token:    "invalid token",
+			tokenErr: ctxtg.ErrInvalidToken,
+		},
+		"Error response": {
+			err: entities.ErrReportNotFound,
+		},
+	}
+
+	for label, test := range tests {
+		rc := TestRedmineClient{
+			deleteReport: func(ctx context.Context, tr entities.Tracker, pid entities.ProjectID, id entities.ReportID) error {
+				if test.tokenErr != nil {
+					t.Error("Should not be called", label)
+				}
+				checkCtx(t, label, ctx)
+				checkTracker(t, label, tr)
+				if pid != test.projectID || id != test.reportID {
+					t.Errorf("Test %s invalid params passed", label)
+				}
+				return test.err
+			},
+		}
+		p := &ctxtgtest.Parser{
+			Err:           test.tokenErr,
+			TokenExpected: test.token,
+		}
+
+		r := newAPI(rc, p)
+		err := r.DeleteReport(&DeleteReportReq{
+			Context:   testContext(test.token),
+			Tracker:   testTracker,
+			ProjectID: test.projectID,
+			ReportID:  test.reportID,
+		}, nil)
+
+		if err := p.Error(); err != nil {
+			t.Errorf("Parser error in test %v: %v", label, err)
+		}
+		if (test.err != nil || test.tokenErr != nil) && err == nil {
+			t.Errorf("Test %s should return err", label)
+		}
+	}
+}
+
+func checkCtx(t *testing.T, label string, ctx context.Context) {
+	if ctx == nil {
+		t.Errorf("Test %s passed nil context", label)
+	}
+}
+
+func checkTracker(t *testing.T, label string, tr entities.Tracker) {
+	if tr != testTracker {
+		t.Errorf("Test %s Invalid tracker passed", label)
+	}
+}
+
+var testTracker = entities.Tracker{
+	ID:   30,
+	URL:  "testurl",
+	Type: "RED",
+	Credentials: entities.Credentials{
+		Login:    "str",
+		Password: "pass",
+	},
+}
+
+func testContext(token ctxtg.Token) ctxtg.Context {
+	return ctxtg.Context{
 		Token: token,
 	}
 }
 
 type TestRedmineClient struct {
-	projects            func(context.Context, entities.Tracker, entities.Pagination) ([]entities.Project, int64, error)
+	projects            func(context.Context, entities.Tracker, entities.ProjectFilter, entities.Pagination) ([]entities.Project, int64, error)
 	project             func(context.Context, entities.Tracker, entities.ProjectID) (*entities.Project, error)
-	projectIssues       func(context.Context, entities.Tracker, entities.ProjectID, entities.Pagination) ([]entities.Issue, int64, error)
+	projectIssues       func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueFilter, []entities.IssueSort, entities.Pagination) ([]entities.Issue, int64, error)
 	userInfo            func(context.Context, entities.Tracker) (*entities.User, error)
 	issue               func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) (*entities.Issue, error)
 	issueByURL          func(context.Context, entities.Tracker, entities.IssueURL) (*entities.Issue, error)
 	createIssue         func(context.Context, entities.Tracker, entities.NewIssue, entities.ProjectID) (*entities.Issue, error)
 	updateIssueProgress func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.Progress) error
 	totalReports        func(ctx context.Context, t entities.Tracker, date int64) (int64, error)
-	createReport        func(context.Context, entities.Tracker, entities.ProjectID, entities.Report) error
+	createReport        func(context.Context, entities.Tracker, entities.ProjectID, entities.Report) (entities.ReportID, error)
+	issueStatuses       func(context.Context, entities.Tracker) ([]entities.IssueStatus, error)
+	updateIssueStatus   func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, int64) error
+	issueComments       func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Comment, error)
+	addIssueComment     func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.Comment) error
+	issueAttachments    func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Attachment, error)
+	attachToIssue       func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, []entities.NewAttachment) error
+	downloadAttachment  func(context.Context, entities.Tracker, entities.AttachmentID, io.Writer) (*entities.Attachment, error)
+	issueRelations      func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) ([]entities.Relation, error)
+	createRelation      func(context.Context, entities.Tracker, entities.ProjectID, entities.Relation) (*entities.Relation, error)
+	deleteRelation      func(context.Context, entities.Tracker, entities.ProjectID, entities.RelationID) error
+	projectVersions     func(context.Context, entities.Tracker, entities.ProjectID) ([]entities.Version, error)
+	createVersion       func(context.Context, entities.Tracker, entities.ProjectID, entities.Version) (*entities.Version, error)
+	updateReport        func(context.Context, entities.Tracker, entities.ProjectID, entities.Report) error
+	deleteReport        func(context.Context, entities.Tracker, entities.ProjectID, entities.ReportID) error
+	reports             func(context.Context, entities.Tracker, int64, int64, entities.Pagination) ([]entities.Report, int64, error)
+	reportsSummary      func(context.Context, entities.Tracker, int64, int64) (*entities.ReportsSummary, error)
+	savedQueries        func(context.Context, entities.Tracker, entities.ProjectID) ([]entities.SavedQuery, error)
+	search              func(context.Context, entities.Tracker, entities.SearchQuery, entities.Pagination) ([]entities.SearchResult, int64, error)
+	issueChanges        func(context.Context, entities.Tracker, entities.IssueCursor, int) ([]entities.Issue, entities.IssueCursor, error)
+	wikiIndex           func(context.Context, entities.Tracker, entities.ProjectID) ([]entities.WikiPageInfo, error)
+	wikiPage            func(context.Context, entities.Tracker, entities.ProjectID, string, int64) (*entities.WikiPage, error)
+	apiKey              func(context.Context, entities.Tracker) (string, error)
+	projectTree         func(context.Context, entities.Tracker, entities.ProjectFilter) ([]entities.ProjectNode, error)
+	projectByIdentifier func(context.Context, entities.Tracker, string) (*entities.Project, error)
+	projectByURL        func(context.Context, entities.Tracker, entities.ProjectURL) (*entities.Project, error)
+	projectMembers      func(context.Context, entities.Tracker, entities.ProjectID, entities.Pagination) ([]entities.ProjectMember, int64, error)
+	issuePriorities     func(context.Context, entities.Tracker) ([]entities.IssuePriority, error)
+	issueCategories     func(context.Context, entities.Tracker, entities.ProjectID) ([]entities.IssueCategory, error)
+	updateIssue         func(context.Context, entities.Tracker, entities.ProjectID, entities.Issue) (*entities.Issue, error)
+	closeIssue          func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) (*entities.Issue, error)
+	deleteIssue         func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID) error
+	moveIssue           func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.ProjectID) (*entities.Issue, error)
+	copyIssue           func(context.Context, entities.Tracker, entities.ProjectID, entities.IssueID, entities.IssueCopy) (*entities.Issue, error)
 }
 
-func (r TestRedmineClient) Projects(ctx context.Context, t entities.Tracker, p entities.Pagination) ([]entities.Project, int64, error) {
-	return r.projects(ctx, t, p)
+func (r TestRedmineClient) Projects(ctx context.Context, t entities.Tracker, f entities.ProjectFilter, p entities.Pagination) ([]entities.Project, int64, error) {
+	return r.projects(ctx, t, f, p)
 }
 
 func (r TestRedmineClient) Project(ctx context.Context, t entities.Tracker, pid entities.ProjectID) (*entities.Project, error) {
 	return r.project(ctx, t, pid)
 }
 
-func (r TestRedmineClient) ProjectIssues(ctx context.Context, t entities.Tracker, id entities.ProjectID, p entities.Pagination) ([]entities.Issue, int64, error) {
-	return r.projectIssues(ctx, t, id, p)
+func (r TestRedmineClient) ProjectIssues(ctx context.Context, t entities.Tracker, id entities.ProjectID, f entities.IssueFilter, s []entities.IssueSort, p entities.Pagination) ([]entities.Issue, int64, error) {
+	return r.projectIssues(ctx, t, id, f, s, p)
 }
 
 func (r TestRedmineClient) UserInfo(ctx context.Context, t entities.Tracker) (*entities.User, error) {
@@ -803,6 +3116,138 @@ func (r TestRedmineClient) TotalReports(ctx context.Context, t entities.Tracker,
 	return r.totalReports(ctx, t, date)
 }
 
-func (r TestRedmineClient) CreateReport(ctx context.Context, t entities.Tracker, pid entities.ProjectID, rep entities.Report) error {
+func (r TestRedmineClient) CreateReport(ctx context.Context, t entities.Tracker, pid entities.ProjectID, rep entities.Report) (entities.ReportID, error) {
 	return r.createReport(ctx, t, pid, rep)
 }
+
+func (r TestRedmineClient) IssueStatuses(ctx context.Context, t entities.Tracker) ([]entities.IssueStatus, error) {
+	return r.issueStatuses(ctx, t)
+}
+
+func (r TestRedmineClient) UpdateIssueStatus(ctx context.Context, t entities.Tracker, pid entities.ProjectID, iid entities.IssueID, sid int64) error {
+	return r.updateIssueStatus(ctx, t, pid, iid, sid)
+}
+
+func (r TestRedmineClient) IssueComments(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) ([]entities.Comment, error) {
+	return r.issueComments(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) AddIssueComment(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, c entities.Comment) error {
+	return r.addIssueComment(ctx, t, pid, id, c)
+}
+
+func (r TestRedmineClient) IssueAttachments(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) ([]entities.Attachment, error) {
+	return r.issueAttachments(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) AttachToIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, as []entities.NewAttachment) error {
+	return r.attachToIssue(ctx, t, pid, id, as)
+}
+
+func (r TestRedmineClient) DownloadAttachment(ctx context.Context, t entities.Tracker, id entities.AttachmentID, w io.Writer) (*entities.Attachment, error) {
+	return r.downloadAttachment(ctx, t, id, w)
+}
+
+func (r TestRedmineClient) IssueRelations(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) ([]entities.Relation, error) {
+	return r.issueRelations(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) CreateRelation(ctx context.Context, t entities.Tracker, pid entities.ProjectID, rel entities.Relation) (*entities.Relation, error) {
+	return r.createRelation(ctx, t, pid, rel)
+}
+
+func (r TestRedmineClient) DeleteRelation(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.RelationID) error {
+	return r.deleteRelation(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) ProjectVersions(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.Version, error) {
+	return r.projectVersions(ctx, t, pid)
+}
+
+func (r TestRedmineClient) CreateVersion(ctx context.Context, t entities.Tracker, pid entities.ProjectID, v entities.Version) (*entities.Version, error) {
+	return r.createVersion(ctx, t, pid, v)
+}
+
+func (r TestRedmineClient) UpdateReport(ctx context.Context, t entities.Tracker, pid entities.ProjectID, rep entities.Report) error {
+	return r.updateReport(ctx, t, pid, rep)
+}
+
+func (r TestRedmineClient) DeleteReport(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.ReportID) error {
+	return r.deleteReport(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) Reports(ctx context.Context, t entities.Tracker, from int64, to int64, p entities.Pagination) ([]entities.Report, int64, error) {
+	return r.reports(ctx, t, from, to, p)
+}
+
+func (r TestRedmineClient) ReportsSummary(ctx context.Context, t entities.Tracker, from int64, to int64) (*entities.ReportsSummary, error) {
+	return r.reportsSummary(ctx, t, from, to)
+}
+
+func (r TestRedmineClient) SavedQueries(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.SavedQuery, error) {
+	return r.savedQueries(ctx, t, pid)
+}
+
+func (r TestRedmineClient) Search(ctx context.Context, t entities.Tracker, s entities.SearchQuery, p entities.Pagination) ([]entities.SearchResult, int64, error) {
+	return r.search(ctx, t, s, p)
+}
+
+func (r TestRedmineClient) IssueChanges(ctx context.Context, t entities.Tracker, c entities.IssueCursor, limit int) ([]entities.Issue, entities.IssueCursor, error) {
+	return r.issueChanges(ctx, t, c, limit)
+}
+
+func (r TestRedmineClient) WikiIndex(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.WikiPageInfo, error) {
+	return r.wikiIndex(ctx, t, pid)
+}
+
+func (r TestRedmineClient) WikiPage(ctx context.Context, t entities.Tracker, pid entities.ProjectID, title string, version int64) (*entities.WikiPage, error) {
+	return r.wikiPage(ctx, t, pid, title, version)
+}
+
+func (r TestRedmineClient) APIKey(ctx context.Context, t entities.Tracker) (string, error) {
+	return r.apiKey(ctx, t)
+}
+
+func (r TestRedmineClient) ProjectTree(ctx context.Context, t entities.Tracker, f entities.ProjectFilter) ([]entities.ProjectNode, error) {
+	return r.projectTree(ctx, t, f)
+}
+
+func (r TestRedmineClient) ProjectByIdentifier(ctx context.Context, t entities.Tracker, identifier string) (*entities.Project, error) {
+	return r.projectByIdentifier(ctx, t, identifier)
+}
+
+func (r TestRedmineClient) ProjectByURL(ctx context.Context, t entities.Tracker, u entities.ProjectURL) (*entities.Project, error) {
+	return r.projectByURL(ctx, t, u)
+}
+
+func (r TestRedmineClient) ProjectMembers(ctx context.Context, t entities.Tracker, pid entities.ProjectID, p entities.Pagination) ([]entities.ProjectMember, int64, error) {
+	return r.projectMembers(ctx, t, pid, p)
+}
+
+func (r TestRedmineClient) IssuePriorities(ctx context.Context, t entities.Tracker) ([]entities.IssuePriority, error) {
+	return r.issuePriorities(ctx, t)
+}
+
+func (r TestRedmineClient) IssueCategories(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.IssueCategory, error) {
+	return r.issueCategories(ctx, t, pid)
+}
+
+func (r TestRedmineClient) UpdateIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, i entities.Issue) (*entities.Issue, error) {
+	return r.updateIssue(ctx, t, pid, i)
+}
+
+func (r TestRedmineClient) CloseIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) (*entities.Issue, error) {
+	return r.closeIssue(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) DeleteIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) error {
+	return r.deleteIssue(ctx, t, pid, id)
+}
+
+func (r TestRedmineClient) MoveIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, target entities.ProjectID) (*entities.Issue, error) {
+	return r.moveIssue(ctx, t, pid, id, target)
+}
+
+func (r TestRedmineClient) CopyIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, c entities.IssueCopy) (*entities.Issue, error) {
+	return r.copyIssue(ctx, t, pid, id, c)
+}
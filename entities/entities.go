@@ -3,11 +3,37 @@ package entities
 // Project representation in our system
 type Project struct {
 	ID            ProjectID
+	ParentID      ProjectID
+	Identifier    string
 	Title         string
 	Link          string
 	Description   string
+	Status        string
+	Modules       []string
+	Created       int64
+	Updated       int64
 	IssueTypes    []TypeID
 	ActivityTypes []TypeID
+	CustomFields  []CustomFieldDefinition
+}
+
+// Project statuses
+const (
+	ProjectStatusActive   = "active"
+	ProjectStatusClosed   = "closed"
+	ProjectStatusArchived = "archived"
+)
+
+// ProjectFilter narrows down projects list.
+// ActiveOnly hides closed and archived projects.
+type ProjectFilter struct {
+	ActiveOnly bool
+}
+
+// ProjectNode represents project with its subprojects
+type ProjectNode struct {
+	Project
+	Children []ProjectNode
 }
 
 // Tracker representation in our system
@@ -19,9 +45,13 @@ type Tracker struct {
 }
 
 // Credentials to tracker
+// APIKey is used instead of Login and Password when set.
+// SwitchUser is login of user to act as, it requires admin credentials.
 type Credentials struct {
-	Login    string
-	Password string
+	Login      string
+	Password   string
+	APIKey     string
+	SwitchUser string
 }
 
 // TypeID used for IssueTypes and ActivityTypes
@@ -32,16 +62,175 @@ type TypeID struct {
 
 // Issue representation in our system
 type Issue struct {
-	ID          IssueID
-	ProjectID   ProjectID `json:"-"`
-	Type        TypeID
-	Title       string
+	ID           IssueID
+	ProjectID    ProjectID `json:"-"`
+	Type         TypeID
+	Status       TypeID
+	Title        string
+	Description  string
+	Estimate     int64
+	DueDate      int64
+	Done         Progress
+	Spent        int64
+	URL          string
+	Created      int64
+	Updated      int64
+	CustomFields []CustomField
+	Relations    []Relation
+	// Version is nil when issue has no version.
+	// Non nil Version with zero ID clears issue version on update.
+	Version *TypeID
+	// ParentID is zero for top level issues
+	ParentID IssueID
+	Children []IssueChild
+	// TotalSpent and TotalEstimate include values of all subtasks
+	TotalSpent    int64
+	TotalEstimate int64
+	// Assignee is nil when issue is not assigned.
+	// Unless Assignee is listed in Fields nil Assignee assigns new issue to current user
+	// and keeps assignee on update, non nil Assignee with zero ID assigns issue to nobody.
+	// Listed in Fields nil Assignee assigns issue to nobody as well.
+	Assignee *TypeID
+	// Zero Priority ID sets default priority on create and keeps priority on update,
+	// even when Priority is listed in Fields.
+	Priority TypeID
+	// Category is nil when issue has no category.
+	// Non nil Category with zero ID clears issue category on update.
+	Category *TypeID
+	// StartDate is UNIX timestamp (seconds) like DueDate
+	StartDate int64
+	Private   bool
+	// Fields lists fields sent to tracker on create and update,
+	// listed fields with zero value or nil pointer are cleared,
+	// except of Type, Status and Priority which are kept when zero.
+	// Empty Fields sends fields with non zero value only,
+	// so nil Version, Assignee and Category are kept.
+	Fields []IssueField `json:",omitempty"`
+}
+
+// IssueField names field of Issue which is sent to tracker
+type IssueField string
+
+// Issue fields
+const (
+	IssueFieldTitle        IssueField = "Title"
+	IssueFieldDescription  IssueField = "Description"
+	IssueFieldType         IssueField = "Type"
+	IssueFieldStatus       IssueField = "Status"
+	IssueFieldPriority     IssueField = "Priority"
+	IssueFieldAssignee     IssueField = "Assignee"
+	IssueFieldCategory     IssueField = "Category"
+	IssueFieldVersion      IssueField = "Version"
+	IssueFieldParent       IssueField = "ParentID"
+	IssueFieldStartDate    IssueField = "StartDate"
+	IssueFieldDueDate      IssueField = "DueDate"
+	IssueFieldEstimate     IssueField = "Estimate"
+	IssueFieldDone         IssueField = "Done"
+	IssueFieldPrivate      IssueField = "Private"
+	IssueFieldCustomFields IssueField = "CustomFields"
+)
+
+// IssueCursor is position in feed of issue changes.
+// Updated is UNIX timestamp (seconds) of last seen issue change,
+// LastID is ID of last seen issue changed at Updated.
+// Zero cursor starts feed from the very first issue.
+type IssueCursor struct {
+	Updated int64
+	LastID  IssueID
+}
+
+// IssueChild represents subtask of issue with its own subtasks
+type IssueChild struct {
+	ID       IssueID
+	Type     TypeID
+	Title    string
+	Children []IssueChild
+}
+
+// CustomField represents value of tracker custom field.
+// Values contains single element for not multiple fields.
+type CustomField struct {
+	ID       int64
+	Name     string
+	Multiple bool
+	Values   []string
+}
+
+// CustomFieldDefinition describes custom field which could be set on project issues.
+// Format, Required, Multiple, PossibleValues and DefaultValue are filled only
+// when tracker allows to read custom fields definitions (admin account).
+type CustomFieldDefinition struct {
+	ID             int64
+	Name           string
+	Format         string
+	Required       bool
+	Multiple       bool
+	PossibleValues []string
+	DefaultValue   string
+}
+
+// Relation represents link between two issues.
+// Type is one of the tracker relation types: relates, duplicates, duplicated,
+// blocks, blocked, precedes, follows, copied_to, copied_from.
+// Delay in days is used only by precedes and follows relations.
+type Relation struct {
+	ID        RelationID
+	IssueID   IssueID
+	IssueToID IssueID
+	Type      string
+	Delay     int
+}
+
+// Version represents project version (milestone).
+// Status is one of: open, locked, closed.
+// Sharing is one of: none, descendants, hierarchy, tree, system.
+type Version struct {
+	ID          VersionID
+	ProjectID   ProjectID
+	Name        string
 	Description string
-	Estimate    int64
+	Status      string
+	Sharing     string
 	DueDate     int64
-	Done        Progress
-	Spent       int64
-	URL         string
+}
+
+// WikiPageInfo represents page in project wiki index.
+// Parent is title of parent page, empty for top level pages.
+type WikiPageInfo struct {
+	Title   string
+	Parent  string
+	Version int64
+	Created int64
+	Updated int64
+}
+
+// WikiPage represents content of wiki page version
+type WikiPage struct {
+	WikiPageInfo
+	Text     string
+	Author   User
+	Comments string
+}
+
+// IssuePriority represents issue priority available on tracker
+type IssuePriority struct {
+	ID        int64
+	Name      string
+	IsDefault bool
+}
+
+// IssueCategory represents issue category of project
+type IssueCategory struct {
+	ID        int64
+	ProjectID ProjectID
+	Name      string
+}
+
+// IssueStatus represents issue status available on tracker
+type IssueStatus struct {
+	ID       int64
+	Name     string
+	IsClosed bool
 }
 
 // NewIssue differs from the Issye in a Type field.
@@ -52,6 +241,26 @@ type NewIssue struct {
 	// Type field inside Issue will be empty
 	// We have only id of Type with NewIssue
 	Type int64
+	// Attachments are uploaded to tracker and attached to created issue
+	Attachments []NewAttachment
+}
+
+// IssueCopy describes copy of issue to project ProjectID.
+// Subtasks and Attachments are copied along with issue when set,
+// Link relates source issue to its copy with copied_to relation.
+type IssueCopy struct {
+	ProjectID   ProjectID
+	Subtasks    bool
+	Attachments bool
+	Link        bool
+}
+
+// ProjectMember represents user or group membership in project
+type ProjectMember struct {
+	ID      int64
+	User    TypeID
+	IsGroup bool
+	Roles   []TypeID
 }
 
 // User information from tracker
@@ -61,19 +270,173 @@ type User struct {
 	Mail string
 }
 
+// Comment represents issue comment (journal note) from tracker
+type Comment struct {
+	ID      int64
+	Author  User
+	Text    string
+	Private bool
+	Created int64
+}
+
+// Attachment represents file attached to issue
+type Attachment struct {
+	ID          AttachmentID
+	Filename    string
+	Size        int64
+	ContentType string
+	Description string
+	URL         string
+	Author      User
+	Created     int64
+}
+
+// NewAttachment represents file which should be uploaded to tracker
+type NewAttachment struct {
+	Filename    string
+	ContentType string
+	Description string
+	Content     []byte
+}
+
 // Report represents time report and additional information
 type Report struct {
-	IssueID    IssueID
-	ActivityID int64
-	Comments   string
-	Duration   int64
-	Started    int64
+	ID           ReportID
+	ProjectID    ProjectID
+	IssueID      IssueID
+	ActivityID   int64
+	Comments     string
+	Duration     int64
+	Started      int64
+	CustomFields []CustomField
+	// Fields lists fields sent to tracker on update, listed Comments, IssueID
+	// and CustomFields with zero value are cleared, while ActivityID, Duration and Started
+	// are required by tracker and kept when zero.
+	// Empty Fields sends fields with non zero value only.
+	Fields []ReportField `json:",omitempty"`
+}
+
+// ReportField names field of Report which is sent to tracker
+type ReportField string
+
+// Report fields
+const (
+	ReportFieldIssue        ReportField = "IssueID"
+	ReportFieldActivity     ReportField = "ActivityID"
+	ReportFieldComments     ReportField = "Comments"
+	ReportFieldDuration     ReportField = "Duration"
+	ReportFieldStarted      ReportField = "Started"
+	ReportFieldCustomFields ReportField = "CustomFields"
+)
+
+// ReportsSummary represents reported time between two dates in seconds
+// grouped by day, issue, project and activity.
+// Groups are sorted by ID, ID of day group is UNIX timestamp of the day.
+type ReportsSummary struct {
+	Total      int64
+	ByDay      []ReportsTotal
+	ByIssue    []ReportsTotal
+	ByProject  []ReportsTotal
+	ByActivity []ReportsTotal
+}
+
+// ReportsTotal represents total reported time in seconds for group of reports
+type ReportsTotal struct {
+	ID       int64
+	Name     string
+	Duration int64
+}
+
+// Issue statuses for IssueFilter
+const (
+	IssueStatusOpen   = "open"
+	IssueStatusClosed = "closed"
+	IssueStatusAll    = "all"
+)
+
+// Issue assignees for IssueFilter
+const (
+	AssigneeMe   = "me"
+	AssigneeAny  = "any"
+	AssigneeNone = "none"
+	AssigneeUser = "user"
+)
+
+// IssueFilter narrows down project issues.
+// Zero value returns open issues assigned to current user.
+type IssueFilter struct {
+	// Status is IssueStatusOpen when empty
+	Status     string
+	TrackerIDs []int64
+	// Assignee is AssigneeMe when empty, AssigneeUser requires AssigneeID
+	Assignee   string
+	AssigneeID int64
+	VersionID  VersionID
+	// UpdatedSince and CreatedSince are UNIX timestamps (seconds)
+	UpdatedSince int64
+	CreatedSince int64
+	// Subject matches issues containing text in subject
+	Subject string
+	// QueryID runs tracker saved query, rest of filter fields are ignored then
+	QueryID QueryID
+}
+
+// SavedQuery represents issues query saved on tracker.
+// ProjectID is zero for queries available in all projects.
+type SavedQuery struct {
+	ID        QueryID
+	Name      string
+	Public    bool
+	ProjectID ProjectID
+}
+
+// IssueSort defines issues order by Field, e.g. id, subject, status, tracker,
+// priority, assigned_to, updated_on, created_on, due_date
+type IssueSort struct {
+	Field string
+	Desc  bool
+}
+
+// Search resource types for SearchQuery
+const (
+	SearchIssues     = "issues"
+	SearchWikiPages  = "wiki_pages"
+	SearchNews       = "news"
+	SearchDocuments  = "documents"
+	SearchChangesets = "changesets"
+	SearchMessages   = "messages"
+	SearchProjects   = "projects"
+)
+
+// SearchQuery represents full-text search request.
+// Zero ProjectID searches in all projects, empty Types searches in all resource types.
+type SearchQuery struct {
+	Text       string
+	ProjectID  ProjectID
+	Types      []string
+	TitlesOnly bool
+}
+
+// SearchResult represents found resource.
+// Type is tracker resource type, e.g. issue, issue-closed, wiki-page, news.
+// Updated is UNIX timestamp (seconds) of last resource change.
+type SearchResult struct {
+	ID      int64
+	Type    string
+	Title   string
+	URL     string
+	Snippet string
+	Updated int64
 }
 
 // Pagination used for pagination info in corresponding requests
+// All loads every page from tracker and applies Offset and Limit to the whole list,
+// zero Limit with All returns everything starting from Offset.
+// All is supported by GetProjects only.
 type Pagination struct {
 	Offset int
 	Limit  int
+	All    bool
 }
 
 // ProjectID is helper type to avoid invalid int usage
@@ -82,8 +445,26 @@ type ProjectID int64
 // IssueID is helper type to avoid invalid int usage
 type IssueID int64
 
+// AttachmentID is helper type to avoid invalid int usage
+type AttachmentID int64
+
+// RelationID is helper type to avoid invalid int usage
+type RelationID int64
+
+// QueryID is helper type to avoid invalid int usage
+type QueryID int64
+
+// ReportID is helper type to avoid invalid int usage
+type ReportID int64
+
+// VersionID is helper type to avoid invalid int usage
+type VersionID int64
+
 // Progress represents progress in percents (0-100)
 type Progress int
 
 // IssueURL is helper type to avoid invalid string usage
 type IssueURL string
+
+// ProjectURL is helper type to avoid invalid string usage
+type ProjectURL string
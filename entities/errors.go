@@ -12,12 +12,24 @@ var (
 
 //Tracker services error codes
 var (
-	ErrCredentials     = jsonrpc2.NewError(102, "INVALID_CREDENTIALS")
-	ErrTrackerType     = jsonrpc2.NewError(103, "INVALID_TRACKER_TYPE")
-	ErrTrackerURL      = jsonrpc2.NewError(104, "INVALID_TRACKER_URL")
-	ErrIssueURL        = jsonrpc2.NewError(105, "INVALID_ISSUE_URL")
-	ErrProjectNotFound = jsonrpc2.NewError(106, "PROJECT_NOT_FOUND")
-	ErrIssueNotFound   = jsonrpc2.NewError(107, "ISSUE_NOT_FOUND")
+	ErrCredentials        = jsonrpc2.NewError(102, "INVALID_CREDENTIALS")
+	ErrTrackerType        = jsonrpc2.NewError(103, "INVALID_TRACKER_TYPE")
+	ErrTrackerURL         = jsonrpc2.NewError(104, "INVALID_TRACKER_URL")
+	ErrIssueURL           = jsonrpc2.NewError(105, "INVALID_ISSUE_URL")
+	ErrProjectNotFound    = jsonrpc2.NewError(106, "PROJECT_NOT_FOUND")
+	ErrIssueNotFound      = jsonrpc2.NewError(107, "ISSUE_NOT_FOUND")
+	ErrAttachmentNotFound = jsonrpc2.NewError(108, "ATTACHMENT_NOT_FOUND")
+	ErrRelationNotFound   = jsonrpc2.NewError(109, "RELATION_NOT_FOUND")
+	ErrReportNotFound     = jsonrpc2.NewError(110, "REPORT_NOT_FOUND")
+	ErrTooManyReports     = jsonrpc2.NewError(111, "TOO_MANY_REPORTS")
+	ErrIssueFilter        = jsonrpc2.NewError(112, "INVALID_ISSUE_FILTER")
+	ErrSearchQuery        = jsonrpc2.NewError(113, "INVALID_SEARCH_QUERY")
+	ErrWikiPageNotFound   = jsonrpc2.NewError(114, "WIKI_PAGE_NOT_FOUND")
+	ErrProjectURL         = jsonrpc2.NewError(115, "INVALID_PROJECT_URL")
+	ErrNoClosedStatus     = jsonrpc2.NewError(116, "CLOSED_STATUS_NOT_FOUND")
+	ErrNotConfirmed       = jsonrpc2.NewError(117, "NOT_CONFIRMED")
+	ErrTooManyProjects    = jsonrpc2.NewError(118, "TOO_MANY_PROJECTS")
+	ErrAttachmentTooLarge = jsonrpc2.NewError(119, "ATTACHMENT_TOO_LARGE")
 )
 
 const (
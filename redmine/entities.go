@@ -1,6 +1,9 @@
 package redmine
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
 type errorsResult struct {
 	Errors []string `json:"errors"`
@@ -18,13 +21,17 @@ type projectRoot struct {
 }
 
 type project struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Identifier  string    `json:"identifier"`
-	Description string    `json:"description"`
-	Trackers    []idName  `json:"trackers"`
-	CreatedOn   time.Time `json:"created_on"`
-	UpdatedOn   time.Time `json:"updated_on"`
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Identifier        string    `json:"identifier"`
+	Description       string    `json:"description"`
+	Parent            *idName   `json:"parent"`
+	Status            int       `json:"status"`
+	Trackers          []idName  `json:"trackers"`
+	IssueCustomFields []idName  `json:"issue_custom_fields"`
+	EnabledModules    []idName  `json:"enabled_modules"`
+	CreatedOn         time.Time `json:"created_on"`
+	UpdatedOn         time.Time `json:"updated_on"`
 }
 
 type userRoot struct {
@@ -52,36 +59,259 @@ type issueRoot struct {
 }
 
 type issue struct {
-	ID             int64   `json:"id,omitempty"`
-	Project        *idName `json:"project,omitempty"`
-	Tracker        *idName `json:"tracker,omitempty"`
-	TrackerID      int64   `json:"tracker_id,omitempty"`
-	Status         *idName `json:"status,omitempty"`
-	Priority       *idName `json:"priority,omitempty"`
-	Author         *idName `json:"author,omitempty"`
-	AssignedToID   int64   `json:"assigned_to_id,omitempty"`
-	AssignedTo     *idName `json:"assigned_to,omitempty"`
-	FixedVersion   *idName `json:"fixed_version,omitempty"`
-	Subject        string  `json:"subject,omitempty"`
-	Description    string  `json:"description,omitempty"`
-	StartDate      string  `json:"start_date,omitempty"`
-	DueDate        string  `json:"due_date,omitempty"`
-	DoneRatio      int     `json:"done_ratio,omitempty"`
-	SpentHours     float64 `json:"spent_hours,omitempty"`
-	EstimatedHours float64 `json:"estimated_hours,omitempty"`
-	CustomFields   []struct {
-		ID    int    `json:"id"`
-		Name  string `json:"name"`
-		Value string `json:"value"`
-	} `json:"custom_fields,omitempty"`
+	ID                  int64         `json:"id,omitempty"`
+	Project             *idName       `json:"project,omitempty"`
+	Tracker             *idName       `json:"tracker,omitempty"`
+	Status              *idName       `json:"status,omitempty"`
+	Priority            *idName       `json:"priority,omitempty"`
+	Category            *idName       `json:"category,omitempty"`
+	Author              *idName       `json:"author,omitempty"`
+	AssignedTo          *idName       `json:"assigned_to,omitempty"`
+	FixedVersion        *idName       `json:"fixed_version,omitempty"`
+	Subject             string        `json:"subject,omitempty"`
+	Description         string        `json:"description,omitempty"`
+	StartDate           string        `json:"start_date,omitempty"`
+	DueDate             string        `json:"due_date,omitempty"`
+	DoneRatio           int           `json:"done_ratio,omitempty"`
+	SpentHours          float64       `json:"spent_hours,omitempty"`
+	EstimatedHours      float64       `json:"estimated_hours,omitempty"`
+	TotalSpentHours     float64       `json:"total_spent_hours,omitempty"`
+	TotalEstimatedHours float64       `json:"total_estimated_hours,omitempty"`
+	Parent              *idName       `json:"parent,omitempty"`
+	Children            []issueChild  `json:"children,omitempty"`
+	CustomFields        []customField `json:"custom_fields,omitempty"`
+	Journals            []journal     `json:"journals,omitempty"`
+	Uploads             []upload      `json:"uploads,omitempty"`
+	Attachments         []attachment  `json:"attachments,omitempty"`
+	Relations           []relation    `json:"relations,omitempty"`
+	AllowedStatuses     []issueStatus `json:"allowed_statuses,omitempty"`
+	IsPrivate           bool          `json:"is_private,omitempty"`
+	Notes               string        `json:"notes,omitempty"`
+	PrivateNotes        bool          `json:"private_notes,omitempty"`
+	CreatedOn           time.Time     `json:"created_on"`
+	UpdatedOn           time.Time     `json:"updated_on"`
+}
+
+//issueParamsRoot is body of issue create and update requests
+type issueParamsRoot struct {
+	Issue issueParams `json:"issue"`
+}
+
+//issueParams keeps issue fields by JSON key,
+//absent keys keep issue fields on update and null values clear them
+type issueParams map[string]interface{}
+
+type issueChild struct {
+	ID       int64        `json:"id"`
+	Tracker  *idName      `json:"tracker"`
+	Subject  string       `json:"subject"`
+	Children []issueChild `json:"children"`
+}
+
+type journal struct {
+	ID           int64     `json:"id"`
+	User         *idName   `json:"user"`
+	Notes        string    `json:"notes"`
+	PrivateNotes bool      `json:"private_notes"`
+	CreatedOn    time.Time `json:"created_on"`
+}
+
+type uploadRoot struct {
+	Upload upload `json:"upload"`
+}
+
+type upload struct {
+	Token       string `json:"token"`
+	Filename    string `json:"filename,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type attachmentRoot struct {
+	Attachment attachment `json:"attachment"`
+}
+
+type attachment struct {
+	ID          int64     `json:"id"`
+	Filename    string    `json:"filename"`
+	Filesize    int64     `json:"filesize"`
+	ContentType string    `json:"content_type"`
+	Description string    `json:"description"`
+	ContentURL  string    `json:"content_url"`
+	Author      *idName   `json:"author"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+type relationsRoot struct {
+	Relations []relation `json:"relations"`
+}
+
+type relationRoot struct {
+	Relation relation `json:"relation"`
+}
+
+type relation struct {
+	ID           int64  `json:"id,omitempty"`
+	IssueID      int64  `json:"issue_id,omitempty"`
+	IssueToID    int64  `json:"issue_to_id"`
+	RelationType string `json:"relation_type"`
+	Delay        *int   `json:"delay,omitempty"`
+}
+
+//nullableID is marshaled to null when zero, null clears field on update
+type nullableID int64
+
+func (id nullableID) MarshalJSON() ([]byte, error) {
+	if id == 0 {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(id), 10)), nil
+}
+
+type membershipsRoot struct {
+	Memberships []membership `json:"memberships"`
+	TotalCount  int64        `json:"total_count"`
+	Offset      int64        `json:"offset"`
+	Limit       int64        `json:"limit"`
+}
+
+type membership struct {
+	ID    int64    `json:"id"`
+	User  *idName  `json:"user"`
+	Group *idName  `json:"group"`
+	Roles []idName `json:"roles"`
+}
+
+type wikiPagesRoot struct {
+	WikiPages []wikiPage `json:"wiki_pages"`
+}
+
+type wikiPageRoot struct {
+	WikiPage wikiPage `json:"wiki_page"`
+}
+
+type wikiPage struct {
+	Title     string    `json:"title"`
+	Parent    *title    `json:"parent"`
+	Text      string    `json:"text"`
+	Version   int64     `json:"version"`
+	Author    *idName   `json:"author"`
+	Comments  string    `json:"comments"`
 	CreatedOn time.Time `json:"created_on"`
 	UpdatedOn time.Time `json:"updated_on"`
 }
 
+type title struct {
+	Title string `json:"title"`
+}
+
+type searchRoot struct {
+	Results    []searchResult `json:"results"`
+	TotalCount int64          `json:"total_count"`
+	Offset     int64          `json:"offset"`
+	Limit      int64          `json:"limit"`
+}
+
+type searchResult struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	Type        string    `json:"type"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Datetime    time.Time `json:"datetime"`
+}
+
+type queriesRoot struct {
+	Queries    []query `json:"queries"`
+	TotalCount int64   `json:"total_count"`
+	Limit      int64   `json:"limit"`
+}
+
+type query struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	IsPublic  bool   `json:"is_public"`
+	ProjectID int64  `json:"project_id"`
+}
+
+type versionsRoot struct {
+	Versions   []version `json:"versions"`
+	TotalCount int64     `json:"total_count"`
+}
+
+type versionRoot struct {
+	Version version `json:"version"`
+}
+
+type version struct {
+	ID          int64   `json:"id,omitempty"`
+	Project     *idName `json:"project,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Status      string  `json:"status,omitempty"`
+	DueDate     string  `json:"due_date,omitempty"`
+	Sharing     string  `json:"sharing,omitempty"`
+}
+
+type issueStatusesRoot struct {
+	IssueStatuses []issueStatus `json:"issue_statuses"`
+}
+
+type issueStatus struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	IsClosed bool   `json:"is_closed"`
+}
+
+type issuePrioritiesRoot struct {
+	IssuePriorities []issuePriority `json:"issue_priorities"`
+}
+
+type issuePriority struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default"`
+}
+
+type issueCategoriesRoot struct {
+	IssueCategories []issueCategory `json:"issue_categories"`
+}
+
+type issueCategory struct {
+	ID      int64   `json:"id"`
+	Project *idName `json:"project"`
+	Name    string  `json:"name"`
+}
+
 type timeEntryActivitiesRoot struct {
 	TimeEntryActivities []idName `json:"time_entry_activities"`
 }
 
+//customField value is string, []string or nil
+type customField struct {
+	ID       int64       `json:"id"`
+	Name     string      `json:"name,omitempty"`
+	Multiple bool        `json:"multiple,omitempty"`
+	Value    interface{} `json:"value"`
+}
+
+type customFieldsRoot struct {
+	CustomFields []customFieldDefinition `json:"custom_fields"`
+}
+
+type customFieldDefinition struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	CustomizedType string `json:"customized_type"`
+	FieldFormat    string `json:"field_format"`
+	IsRequired     bool   `json:"is_required"`
+	Multiple       bool   `json:"multiple"`
+	DefaultValue   string `json:"default_value"`
+	PossibleValues []struct {
+		Value string `json:"value"`
+	} `json:"possible_values"`
+}
+
 type idName struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -98,22 +328,27 @@ type timeEntryRoot struct {
 	TimeEntry timeEntry `json:"time_entry"`
 }
 
+//timeEntryParamsRoot is body of time entry update requests
+type timeEntryParamsRoot struct {
+	TimeEntry timeEntryParams `json:"time_entry"`
+}
+
+//timeEntryParams keeps time entry fields by JSON key,
+//absent keys keep time entry fields on update and null values clear them
+type timeEntryParams map[string]interface{}
+
 type timeEntry struct {
-	Activity     *idName `json:"activity,omitempty"`
-	ActivityID   int64   `json:"activity_id,omitempty"`
-	Comments     string  `json:"comments,omitempty"`
-	CreatedOn    string  `json:"created_on,omitempty"`
-	CustomFields []struct {
-		ID    int    `json:"id,omitempty"`
-		Name  string `json:"name,omitempty"`
-		Value string `json:"value,omitempty"`
-	} `json:"custom_fields,omitempty"`
-	Hours     float64 `json:"hours,omitempty"`
-	ID        int64   `json:"id,omitempty"`
-	IssueID   int64   `json:"issue_id,omitempty"`
-	Issue     *idName `json:"issue,omitempty"`
-	Project   *idName `json:"project,omitempty"`
-	SpentOn   string  `json:"spent_on,omitempty"`
-	UpdatedOn string  `json:"updated_on,omitempty"`
-	User      *idName `json:"user,omitempty"`
+	Activity     *idName       `json:"activity,omitempty"`
+	ActivityID   int64         `json:"activity_id,omitempty"`
+	Comments     string        `json:"comments,omitempty"`
+	CreatedOn    string        `json:"created_on,omitempty"`
+	CustomFields []customField `json:"custom_fields,omitempty"`
+	Hours        float64       `json:"hours,omitempty"`
+	ID           int64         `json:"id,omitempty"`
+	IssueID      int64         `json:"issue_id,omitempty"`
+	Issue        *idName       `json:"issue,omitempty"`
+	Project      *idName       `json:"project,omitempty"`
+	SpentOn      string        `json:"spent_on,omitempty"`
+	UpdatedOn    string        `json:"updated_on,omitempty"`
+	User         *idName       `json:"user,omitempty"`
 }
@@ -2,8 +2,10 @@
 package redmine
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -37,15 +39,85 @@ func (r *RestClient) Project(ctx context.Context, tr entities.Tracker, pid entit
 	if err != nil {
 		return nil, err
 	}
+	return r.projectDetails(ctx, tr, p)
+}
+
+//ProjectByIdentifier return project by string identifier like mobile-app or err if not found project
+func (r *RestClient) ProjectByIdentifier(ctx context.Context, tr entities.Tracker, identifier string) (*entities.Project, error) {
+	if !projectIdentifierRegexp.MatchString(identifier) {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project identifier %s", identifier)
+	}
+	var pr projectRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           projectByIdentifierResource(identifier),
+		tracker:            tr,
+		result:             &pr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project identifier %s for tracker ID: %d, URL: %s", identifier, tr.ID, tr.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load project by identifier %s from tracker ID: %d, URL: %s", identifier, tr.ID, tr.URL)
+	}
+	p := toProject(pr.Project)
+	return r.projectDetails(ctx, tr, &p)
+}
+
+//ProjectByURL query project by URL
+//Pattern /projects/([a-z0-9_-]+)
+func (r *RestClient) ProjectByURL(ctx context.Context, tr entities.Tracker, projectURL entities.ProjectURL) (*entities.Project, error) {
+	identifier, err := projectIdentifierFromURL(projectURL)
+	if err != nil {
+		return nil, err
+	}
+	return r.ProjectByIdentifier(ctx, tr, identifier)
+}
+
+//projectDetails adds activities, link and custom fields definitions to project
+func (r *RestClient) projectDetails(ctx context.Context, tr entities.Tracker, p *entities.Project) (*entities.Project, error) {
 	ac, err := r.activities(ctx, tr)
 	if err != nil {
 		return nil, err
 	}
 	p.ActivityTypes = idNamesToTypeID(ac.TimeEntryActivities)
 	p.Link = fullURL(tr, projectByIDLink(p.ID))
+	if len(p.CustomFields) > 0 {
+		cfs, err := r.customFields(ctx, tr)
+		if err != nil {
+			return nil, err
+		}
+		if cfs != nil {
+			addCustomFieldDefinitions(p.CustomFields, *cfs)
+		}
+	}
 	return p, nil
 }
 
+//customFields returns nil without error if user is not allowed to read custom fields definitions
+func (r *RestClient) customFields(ctx context.Context, t entities.Tracker) (*customFieldsRoot, error) {
+	var cfs customFieldsRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           customFieldsResource,
+		tracker:            t,
+		result:             &cfs,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound || err == entities.ErrForbidden {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load custom fields from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	return &cfs, nil
+}
+
 func (r *RestClient) project(ctx context.Context, t entities.Tracker, pid entities.ProjectID) (*entities.Project, error) {
 	var pr projectRoot
 	err := redmineRequest(requestOpts{
@@ -67,9 +139,26 @@ func (r *RestClient) project(ctx context.Context, t entities.Tracker, pid entiti
 	return &project, nil
 }
 
-//Projects returns project list for current user from t
-func (r *RestClient) Projects(ctx context.Context, t entities.Tracker, p entities.Pagination) (projects []entities.Project, totalAmount int64, err error) {
-	pr, err := r.projects(ctx, t)
+//Projects returns project list matching filter f for current user from t
+//Maximum paginatation limit is 100 items unless p.All is set.
+//Trackers before 4.1 ignore status param so f.ActiveOnly loads all pages and filters them
+//to keep pages full and total amount exact.
+func (r *RestClient) Projects(ctx context.Context, t entities.Tracker, f entities.ProjectFilter, p entities.Pagination) (projects []entities.Project, totalAmount int64, err error) {
+	var pr *projectsRoot
+	if p.All || f.ActiveOnly {
+		if !p.All && (p.Limit <= 0 || p.Limit > projectsPageLimit) {
+			p.Limit = projectsPageLimit
+		}
+		pr, err = r.allProjects(ctx, t, f)
+		if err == nil {
+			pr.Projects = filterProjects(pr.Projects, f)
+			pr.TotalCount = int64(len(pr.Projects))
+			from, to := pageOf(len(pr.Projects), p)
+			pr.Projects = pr.Projects[from:to]
+		}
+	} else {
+		pr, err = r.projects(ctx, t, f, p)
+	}
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "projects request failed")
 	}
@@ -83,10 +172,27 @@ func (r *RestClient) Projects(ctx context.Context, t entities.Tracker, p entitie
 	return ps, pr.TotalCount, nil
 }
 
-//ProjectIssues returns issues for user from tracker by projectID and total amount of them
+//ProjectTree returns projects matching filter f for current user from t nested under parent projects
+func (r *RestClient) ProjectTree(ctx context.Context, t entities.Tracker, f entities.ProjectFilter) ([]entities.ProjectNode, error) {
+	pr, err := r.allProjects(ctx, t, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "projects request failed")
+	}
+	pr.Projects = filterProjects(pr.Projects, f)
+	ac, err := r.activities(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "activities request failed")
+	}
+	ps := toProjects(*pr)
+	addActivities(ps, *ac)
+	addLinks(ps, t)
+	return toProjectTree(ps), nil
+}
+
+//ProjectIssues returns issues matching filter f from tracker by projectID in order s and total amount of them
 //Maximum paginatation limit is 100 items
-func (r *RestClient) ProjectIssues(ctx context.Context, t entities.Tracker, projectID entities.ProjectID, p entities.Pagination) ([]entities.Issue, int64, error) {
-	issues, amount, err := r.projectIssues(ctx, t, projectID, p)
+func (r *RestClient) ProjectIssues(ctx context.Context, t entities.Tracker, projectID entities.ProjectID, f entities.IssueFilter, s []entities.IssueSort, p entities.Pagination) ([]entities.Issue, int64, error) {
+	issues, amount, err := r.projectIssues(ctx, t, projectID, f, s, p)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -101,12 +207,16 @@ func (r *RestClient) ProjectIssues(ctx context.Context, t entities.Tracker, proj
 	return fullIssues, amount, nil
 }
 
-func (r *RestClient) projectIssues(ctx context.Context, t entities.Tracker, projectID entities.ProjectID, p entities.Pagination) ([]entities.Issue, int64, error) {
+func (r *RestClient) projectIssues(ctx context.Context, t entities.Tracker, projectID entities.ProjectID, f entities.IssueFilter, s []entities.IssueSort, p entities.Pagination) ([]entities.Issue, int64, error) {
 	var issues issuesRoot
-	err := redmineRequest(requestOpts{
+	resource, err := projectIssuesResource(projectID, f, s, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
-		resource:           projectIssuesResource(projectID, p),
+		resource:           resource,
 		tracker:            t,
 		result:             &issues,
 		method:             get,
@@ -121,17 +231,61 @@ func (r *RestClient) projectIssues(ctx context.Context, t entities.Tracker, proj
 	return toIssues(issues, t), issues.TotalCount, nil
 }
 
+//IssueChanges returns up to limit issues created or updated after cursor c and cursor of last returned issue.
+//Redmine filters by update time with precision of seconds, so issues changed at cursor time
+//are returned again by tracker and skipped here by ID.
+//Issues are returned without relations and children, deleted issues are not reported.
+func (r *RestClient) IssueChanges(ctx context.Context, t entities.Tracker, c entities.IssueCursor, limit int) ([]entities.Issue, entities.IssueCursor, error) {
+	if limit <= 0 || limit > issueChangesPageLimit {
+		limit = issueChangesPageLimit
+	}
+	var changes []entities.Issue
+	p := entities.Pagination{Limit: issueChangesPageLimit}
+	for len(changes) < limit {
+		var ir issuesRoot
+		err := redmineRequest(requestOpts{
+			httpClient:         r.httpClient,
+			ctx:                ctx,
+			resource:           issueChangesResource(c, p),
+			tracker:            t,
+			result:             &ir,
+			method:             get,
+			validateStatusFunc: validateStatusOK,
+		})
+		if err == errNotFound {
+			return nil, c, errors.Wrapf(entities.ErrTrackerURL, "failed to load issue changes from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+		}
+		if err != nil {
+			return nil, c, errors.Wrapf(err, "failed to load issue changes from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+		}
+		for _, i := range toIssues(ir, t) {
+			if !seenIssue(i, c) && len(changes) < limit {
+				changes = append(changes, i)
+			}
+		}
+		p.Offset += len(ir.Issues)
+		if len(ir.Issues) < p.Limit || int64(p.Offset) >= ir.TotalCount {
+			break
+		}
+	}
+	if len(changes) == 0 {
+		return nil, c, nil
+	}
+	last := changes[len(changes)-1]
+	return changes, entities.IssueCursor{Updated: last.Updated, LastID: last.ID}, nil
+}
+
 func (r *RestClient) parallelFullIssues(ctx context.Context, t entities.Tracker, ids []entities.IssueID) ([]entities.Issue, error) {
-	pairsC := make(chan issueErrPair)
-	f := func(id entities.IssueID) {
-		var p issueErrPair
+	pairsC := make(chan issueErrPair, len(ids))
+	f := func(i int, id entities.IssueID) {
+		p := issueErrPair{index: i}
 		p.issue, p.err = r.issue(ctx, t, id)
 		pairsC <- p
 	}
-	for _, id := range ids {
-		go f(id)
+	for i, id := range ids {
+		go f(i, id)
 	}
-	var fullIssues []entities.Issue
+	fullIssues := make([]entities.Issue, len(ids))
 	for range ids {
 		pair := <-pairsC
 		if pair.err != nil {
@@ -140,12 +294,14 @@ func (r *RestClient) parallelFullIssues(ctx context.Context, t entities.Tracker,
 		if pair.issue == nil {
 			return nil, entities.ErrIssueNotFound
 		}
-		fullIssues = append(fullIssues, *pair.issue)
+		fullIssues[pair.index] = *pair.issue
 	}
 	return fullIssues, nil
 }
 
+//issueErrPair keeps index of issue to preserve order of issues
 type issueErrPair struct {
+	index int
 	issue *entities.Issue
 	err   error
 }
@@ -176,6 +332,30 @@ func (r *RestClient) UserInfo(ctx context.Context, t entities.Tracker) (*entitie
 	}, nil
 }
 
+//APIKey exchanges credentials of tracker t to API key of user
+func (r *RestClient) APIKey(ctx context.Context, t entities.Tracker) (string, error) {
+	var u userRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		resource:           currentUserResourse,
+		ctx:                ctx,
+		tracker:            t,
+		result:             &u,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return "", errors.Wrapf(entities.ErrTrackerURL, "failed to load API key from tracker ID: %d,login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to load API key from tracker ID: %d, URL: %s", t.ID, t.URL)
+	}
+	if u.User.APIKey == "" {
+		return "", errors.Wrapf(entities.ErrForbidden, "API key is not available for login %s from tracker ID: %d, URL: %s", t.Credentials.Login, t.ID, t.URL)
+	}
+	return u.User.APIKey, nil
+}
+
 //Issue query issue by ID from tracker t
 func (r *RestClient) Issue(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID) (*entities.Issue, error) {
 	return r.issue(ctx, t, issueID)
@@ -186,7 +366,7 @@ func (r *RestClient) issue(ctx context.Context, t entities.Tracker, issueID enti
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
-		resource:           issueByIDResource(issueID),
+		resource:           issueWithDetailsResource(issueID),
 		tracker:            t,
 		result:             &ir,
 		method:             get,
@@ -212,7 +392,7 @@ func (r *RestClient) IssueByURL(ctx context.Context, t entities.Tracker, issueUR
 	return r.issue(ctx, t, id)
 }
 
-//CreateIssue for projectID and assign it to user
+//CreateIssue for projectID and assign it to user unless other assignee is set
 func (r *RestClient) CreateIssue(ctx context.Context, t entities.Tracker, i entities.NewIssue, projectID entities.ProjectID) (*entities.Issue, error) {
 	u, err := r.UserInfo(ctx, t)
 	if err != nil {
@@ -223,11 +403,21 @@ func (r *RestClient) CreateIssue(ctx context.Context, t entities.Tracker, i enti
 
 func (r *RestClient) createIssue(ctx context.Context, t entities.Tracker, i entities.NewIssue, projectID entities.ProjectID, userID int64) (*entities.Issue, error) {
 	var ir issueRoot
-	newIssue := i.Issue
-	newIssue.Type.ID = i.Type
-	issue := toIssueRoot(newIssue)
-	issue.Issue.AssignedToID = userID
-	err := redmineRequest(requestOpts{
+	issue := toIssueParams(i.Issue)
+	if i.Type != 0 {
+		issue.Issue["tracker_id"] = i.Type
+	}
+	if _, ok := issue.Issue["assigned_to_id"]; !ok {
+		issue.Issue["assigned_to_id"] = userID
+	}
+	uploads, err := r.uploads(ctx, t, i.Attachments)
+	if err != nil {
+		return nil, err
+	}
+	if len(uploads) > 0 {
+		issue.Issue["uploads"] = uploads
+	}
+	err = redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
 		resource:           createIssueResource(projectID),
@@ -250,8 +440,9 @@ func (r *RestClient) createIssue(ctx context.Context, t entities.Tracker, i enti
 //UpdateIssueProgress updates issue progress by issue id
 func (r *RestClient) UpdateIssueProgress(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, pr entities.Progress) error {
 	err := r.updateIssue(ctx, t, entities.Issue{
-		ID:   id,
-		Done: pr,
+		ID:     id,
+		Done:   pr,
+		Fields: []entities.IssueField{entities.IssueFieldDone},
 	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to update issue progress for tracker ID: %d, URL: %s", t.ID, t.URL)
@@ -269,14 +460,18 @@ func (r *RestClient) UpdateIssue(ctx context.Context, t entities.Tracker, pid en
 }
 
 func (r *RestClient) updateIssue(ctx context.Context, t entities.Tracker, i entities.Issue) error {
+	return r.putIssue(ctx, t, i.ID, toIssueParams(i))
+}
+
+func (r *RestClient) putIssue(ctx context.Context, t entities.Tracker, id entities.IssueID, body interface{}) error {
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
-		resource:           issueByIDResource(i.ID),
+		resource:           issueByIDResource(id),
 		tracker:            t,
 		method:             put,
-		body:               toIssueRoot(i),
-		validateStatusFunc: validateStatusOK,
+		body:               body,
+		validateStatusFunc: validateStatusNoContent,
 	})
 	if err == errNotFound {
 		return entities.ErrIssueNotFound
@@ -284,82 +479,976 @@ func (r *RestClient) updateIssue(ctx context.Context, t entities.Tracker, i enti
 	return err
 }
 
-//TotalReports returns seconds amount for user 1 day for date
-func (r *RestClient) TotalReports(ctx context.Context, t entities.Tracker, date int64) (int64, error) {
-	var ts timeEntriesRoot
+//IssuePriorities returns all issue priorities available on tracker t
+func (r *RestClient) IssuePriorities(ctx context.Context, t entities.Tracker) ([]entities.IssuePriority, error) {
+	var pr issuePrioritiesRoot
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
-		resource:           timeEntriesResource(date),
+		resource:           issuePrioritiesResource,
 		tracker:            t,
-		result:             &ts,
+		result:             &pr,
 		method:             get,
 		validateStatusFunc: validateStatusOK,
 	})
 	if err == errNotFound {
-		return 0, errors.Wrapf(entities.ErrTrackerURL, "failed to load total reports from tracker ID %s, user login: %s, date %d", t.ID, t.Credentials.Login, date)
+		return nil, errors.Wrapf(entities.ErrTrackerURL, "failed to load issue priorities from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
 	}
 	if err != nil {
-		return 0, errors.Wrapf(err, "failed to load total reports from tracker ID %s, user login: %s, date %d", t.ID, t.Credentials.Login, date)
+		return nil, errors.Wrapf(err, "failed to load issue priorities from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
 	}
-	return sumReportHours(ts.TimeEntries), nil
+	return toIssuePriorities(pr), nil
 }
 
-//CreateReport for user
-func (r *RestClient) CreateReport(ctx context.Context, t entities.Tracker, _ entities.ProjectID, rep entities.Report) error {
+//IssueCategories returns issue categories of project pid
+func (r *RestClient) IssueCategories(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.IssueCategory, error) {
+	var cr issueCategoriesRoot
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
-		resource:           reportsResource,
+		resource:           issueCategoriesResource(pid),
 		tracker:            t,
-		method:             post,
-		body:               reportToTimeEntry(rep),
-		validateStatusFunc: validateStatusCreated,
+		result:             &cr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load issue categories of project ID %d from tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	return toIssueCategories(cr), nil
+}
+
+//IssueStatuses returns all issue statuses available on tracker t
+func (r *RestClient) IssueStatuses(ctx context.Context, t entities.Tracker) ([]entities.IssueStatus, error) {
+	var sr issueStatusesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueStatusesResource,
+		tracker:            t,
+		result:             &sr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
 	})
 	if err == errNotFound {
-		return errors.Wrapf(entities.ErrIssueNotFound, "invalid issueID %d for tracker ID: %d, URL: %s", rep.IssueID, t.ID, t.URL)
+		return nil, errors.Wrapf(entities.ErrTrackerURL, "failed to load issue statuses from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load issue statuses from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
 	}
+	return toIssueStatuses(sr), nil
+}
+
+//UpdateIssueStatus moves issue to status with statusID
+func (r *RestClient) UpdateIssueStatus(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID, statusID int64) error {
+	err := r.updateIssue(ctx, t, entities.Issue{
+		ID:     id,
+		Status: entities.TypeID{ID: statusID},
+		Fields: []entities.IssueField{entities.IssueFieldStatus},
+	})
 	if err != nil {
-		return errors.Wrapf(err, "failed to create report for tracker ID: %d, URL: %s", t.ID, t.URL)
+		return errors.Wrapf(err, "failed to update issue status for tracker ID: %d, URL: %s", t.ID, t.URL)
 	}
 	return nil
 }
 
-func sumReportHours(ts []timeEntry) int64 {
-	var totalSeconds int64
-	for _, t := range ts {
-		totalSeconds = totalSeconds + hoursToSeconds(t.Hours)
+//CloseIssue moves issue to first closed status allowed for it and returns updated issue
+func (r *RestClient) CloseIssue(ctx context.Context, t entities.Tracker, pid entities.ProjectID, id entities.IssueID) (*entities.Issue, error) {
+	statusID, err := r.closedStatus(ctx, t, id)
+	if err != nil {
+		return nil, err
 	}
-	return totalSeconds
+	if err := r.UpdateIssueStatus(ctx, t, pid, id, statusID); err != nil {
+		return nil, err
+	}
+	return r.issue(ctx, t, id)
 }
 
-func (r *RestClient) activities(ctx context.Context, t entities.Tracker) (*timeEntryActivitiesRoot, error) {
-	var a timeEntryActivitiesRoot
+//closedStatus returns ID of first closed status allowed for issue,
+//trackers before 5.0 don't return allowed statuses so any closed status is used
+func (r *RestClient) closedStatus(ctx context.Context, t entities.Tracker, id entities.IssueID) (int64, error) {
+	var ir issueRoot
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
+		resource:           issueWithAllowedStatusesResource(id),
 		tracker:            t,
-		resource:           timeEntriesActivities,
-		result:             &a,
+		result:             &ir,
 		method:             get,
 		validateStatusFunc: validateStatusOK,
 	})
 	if err == errNotFound {
-		return nil, errors.Wrapf(entities.ErrTrackerURL, "failed to load activities from tracker ID: %d,login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+		return 0, errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
 	}
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to load activities from tracker ID: %d,login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+		return 0, errors.Wrapf(err, "failed to load allowed statuses of issue ID %d from tracker ID: %d, URL: %s", id, t.ID, t.URL)
 	}
-	return &a, nil
+	statuses := toIssueStatuses(issueStatusesRoot{IssueStatuses: ir.Issue.AllowedStatuses})
+	if ir.Issue.AllowedStatuses == nil {
+		statuses, err = r.IssueStatuses(ctx, t)
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, s := range statuses {
+		if s.IsClosed {
+			return s.ID, nil
+		}
+	}
+	return 0, errors.Wrapf(entities.ErrNoClosedStatus, "no closed status allowed for issue ID %d on tracker ID: %d, URL: %s", id, t.ID, t.URL)
+}
+
+//DeleteIssue removes issue with its subtasks and time entries
+func (r *RestClient) DeleteIssue(ctx context.Context, t entities.Tracker, _ entities.ProjectID, id entities.IssueID) error {
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueByIDResource(id),
+		tracker:            t,
+		method:             del,
+		validateStatusFunc: validateStatusNoContent,
+	})
+	if err == errNotFound {
+		return errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete issue ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	return nil
+}
+
+//MoveIssue moves issue to project targetID.
+//Issue type not enabled in target project is replaced with first type of the project,
+//category and version are replaced with target project ones of the same name or cleared,
+//assignee who is not a member of target project is cleared.
+func (r *RestClient) MoveIssue(ctx context.Context, t entities.Tracker, _ entities.ProjectID, id entities.IssueID, targetID entities.ProjectID) (*entities.Issue, error) {
+	i, err := r.issue(ctx, t, id)
+	if err != nil {
+		return nil, err
+	}
+	m, err := r.projectMapping(ctx, t, targetID)
+	if err != nil {
+		return nil, err
+	}
+	fields := []entities.IssueField{issueFieldProject, entities.IssueFieldType}
+	if i.Category != nil {
+		fields = append(fields, entities.IssueFieldCategory)
+	}
+	if i.Assignee != nil {
+		fields = append(fields, entities.IssueFieldAssignee)
+	}
+	if i.Version != nil {
+		m.versions, err = r.ProjectVersions(ctx, t, targetID)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, entities.IssueFieldVersion)
+	}
+	err = r.updateIssue(ctx, t, entities.Issue{
+		ID:        id,
+		ProjectID: targetID,
+		Type:      m.issueType(i.Type),
+		Category:  m.category(i.Category),
+		Assignee:  m.assignee(i.Assignee),
+		Version:   m.version(i.Version),
+		Fields:    fields,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to move issue ID %d to project ID %d for tracker ID: %d, URL: %s", id, targetID, t.ID, t.URL)
+	}
+	return r.issue(ctx, t, id)
+}
+
+//CopyIssue creates copy of issue in project c.ProjectID.
+//Type, category and assignee are remapped like in MoveIssue, version and status are not copied.
+//Partially created copy is deleted with its subtasks when any step of copying fails.
+func (r *RestClient) CopyIssue(ctx context.Context, t entities.Tracker, _ entities.ProjectID, id entities.IssueID, c entities.IssueCopy) (*entities.Issue, error) {
+	m, err := r.projectMapping(ctx, t, c.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := r.UserInfo(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	copied, err := r.copyIssue(ctx, t, id, 0, c, m, u.ID)
+	if err == nil && c.Link {
+		_, err = r.CreateRelation(ctx, t, c.ProjectID, entities.Relation{
+			IssueID:   id,
+			IssueToID: copied.ID,
+			Type:      relationCopiedTo,
+		})
+	}
+	if err != nil && copied != nil {
+		return nil, r.removeCopy(ctx, t, copied.ID, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.issue(ctx, t, copied.ID)
+}
+
+//removeCopy deletes partially created copy id, err is returned as the reason of copy failure
+func (r *RestClient) removeCopy(ctx context.Context, t entities.Tracker, id entities.IssueID, err error) error {
+	if delErr := r.DeleteIssue(ctx, t, 0, id); delErr != nil {
+		return errors.Wrapf(err, "failed to remove partial copy ID %d: %v", id, delErr)
+	}
+	return err
+}
+
+//copyIssue creates copy of issue id as subtask of parentID, subtasks are copied recursively.
+//Created copy is returned along with error of subtasks copying.
+func (r *RestClient) copyIssue(ctx context.Context, t entities.Tracker, id, parentID entities.IssueID, c entities.IssueCopy, m *projectMapping, userID int64) (*entities.Issue, error) {
+	i, err := r.issue(ctx, t, id)
+	if err != nil {
+		return nil, err
+	}
+	ni := toIssueCopy(*i, parentID, m)
+	if c.Attachments {
+		ni.Attachments, err = r.attachmentsContent(ctx, t, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	copied, err := r.createIssue(ctx, t, ni, c.ProjectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if c.Subtasks {
+		for _, child := range i.Children {
+			if _, err := r.copyIssue(ctx, t, child.ID, copied.ID, c, m, userID); err != nil {
+				return copied, err
+			}
+		}
+	}
+	return copied, nil
+}
+
+//projectMapping loads issue types, categories and members of project pid
+func (r *RestClient) projectMapping(ctx context.Context, t entities.Tracker, pid entities.ProjectID) (*projectMapping, error) {
+	p, err := r.project(ctx, t, pid)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := r.IssueCategories(ctx, t, pid)
+	if err != nil {
+		return nil, err
+	}
+	members, err := r.projectMemberIDs(ctx, t, pid)
+	if err != nil {
+		return nil, err
+	}
+	return &projectMapping{
+		issueTypes: p.IssueTypes,
+		categories: cs,
+		members:    members,
+	}, nil
+}
+
+//projectMemberIDs returns IDs of users and groups which are members of project pid,
+//nil is returned without error if user is not allowed to read project members
+func (r *RestClient) projectMemberIDs(ctx context.Context, t entities.Tracker, pid entities.ProjectID) (map[int64]bool, error) {
+	ids := make(map[int64]bool)
+	p := entities.Pagination{Limit: membershipsPageLimit}
+	for {
+		ms, total, err := r.ProjectMembers(ctx, t, pid, p)
+		if errors.Cause(err) == entities.ErrForbidden {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range ms {
+			ids[m.User.ID] = true
+		}
+		p.Offset += len(ms)
+		if len(ms) == 0 || int64(p.Offset) >= total {
+			return ids, nil
+		}
+	}
+}
+
+//attachmentsContent downloads all files attached to issue.
+//Returns ErrAttachmentTooLarge before any download if one of files exceeds maxAttachmentSize.
+func (r *RestClient) attachmentsContent(ctx context.Context, t entities.Tracker, id entities.IssueID) ([]entities.NewAttachment, error) {
+	as, err := r.IssueAttachments(ctx, t, 0, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range as {
+		if a.Size > maxAttachmentSize {
+			return nil, errors.Wrapf(entities.ErrAttachmentTooLarge, "attachment ID %d of issue ID %d has %d bytes, limit is %d bytes for tracker ID: %d, URL: %s", a.ID, id, a.Size, maxAttachmentSize, t.ID, t.URL)
+		}
+	}
+	nas := make([]entities.NewAttachment, len(as))
+	for i, a := range as {
+		var buf bytes.Buffer
+		if _, err := r.DownloadAttachment(ctx, t, a.ID, &buf); err != nil {
+			return nil, err
+		}
+		nas[i] = entities.NewAttachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Description: a.Description,
+			Content:     buf.Bytes(),
+		}
+	}
+	return nas, nil
+}
+
+//IssueComments returns non-empty journal notes of issue in chronological order
+func (r *RestClient) IssueComments(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID) ([]entities.Comment, error) {
+	var ir issueRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueWithJournalsResource(issueID),
+		tracker:            t,
+		result:             &ir,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load issue comments by ID %d from tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	return toComments(ir.Issue.Journals), nil
+}
+
+//AddIssueComment adds note to issue, only Text and Private fields of c are used
+func (r *RestClient) AddIssueComment(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID, c entities.Comment) error {
+	err := r.putIssue(ctx, t, issueID, &issueRoot{
+		Issue: issue{
+			Notes:        c.Text,
+			PrivateNotes: c.Private,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to add issue comment for tracker ID: %d, URL: %s", t.ID, t.URL)
+	}
+	return nil
+}
+
+//IssueAttachments returns files attached to issue
+func (r *RestClient) IssueAttachments(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID) ([]entities.Attachment, error) {
+	var ir issueRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueWithAttachmentsResource(issueID),
+		tracker:            t,
+		result:             &ir,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load issue attachments by ID %d from tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	return toAttachments(ir.Issue.Attachments), nil
+}
+
+//AttachToIssue uploads files and attaches them to issue
+func (r *RestClient) AttachToIssue(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID, as []entities.NewAttachment) error {
+	uploads, err := r.uploads(ctx, t, as)
+	if err != nil {
+		return err
+	}
+	err = r.putIssue(ctx, t, issueID, &issueRoot{
+		Issue: issue{
+			Uploads: uploads,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to attach files to issue ID %d for tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	return nil
+}
+
+//DownloadAttachment writes attachment content to w and returns attachment info.
+//Returns ErrAttachmentTooLarge if content exceeds maxAttachmentSize, part of content may be written to w by then.
+func (r *RestClient) DownloadAttachment(ctx context.Context, t entities.Tracker, id entities.AttachmentID, w io.Writer) (*entities.Attachment, error) {
+	var ar attachmentRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           attachmentResource(id),
+		tracker:            t,
+		result:             &ar,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrAttachmentNotFound, "invalid attachment ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load attachment by ID %d from tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if ar.Attachment.Filesize > maxAttachmentSize {
+		return nil, errors.Wrapf(entities.ErrAttachmentTooLarge, "attachment ID %d of %d bytes exceeds limit of %d bytes for tracker ID: %d, URL: %s", id, ar.Attachment.Filesize, maxAttachmentSize, t.ID, t.URL)
+	}
+	err = redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           attachmentDownloadResource(id),
+		tracker:            t,
+		rawResult:          w,
+		rawLimit:           maxAttachmentSize,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrAttachmentNotFound, "invalid attachment ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if err == errTooLarge {
+		return nil, errors.Wrapf(entities.ErrAttachmentTooLarge, "attachment ID %d exceeds limit of %d bytes for tracker ID: %d, URL: %s", id, maxAttachmentSize, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download attachment by ID %d from tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	a := toAttachment(ar.Attachment)
+	return &a, nil
+}
+
+func (r *RestClient) uploads(ctx context.Context, t entities.Tracker, as []entities.NewAttachment) ([]upload, error) {
+	uploads := toUploads(as)
+	for i, a := range as {
+		token, err := r.upload(ctx, t, a)
+		if err != nil {
+			return nil, err
+		}
+		uploads[i].Token = token
+	}
+	return uploads, nil
+}
+
+func (r *RestClient) upload(ctx context.Context, t entities.Tracker, a entities.NewAttachment) (string, error) {
+	var ur uploadRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           uploadsResource(a.Filename),
+		tracker:            t,
+		result:             &ur,
+		method:             post,
+		rawBody:            a.Content,
+		validateStatusFunc: validateStatusCreated,
+	})
+	if err == errNotFound {
+		return "", errors.Wrapf(entities.ErrTrackerURL, "failed to upload file %s to tracker ID: %d, login %s, URL: %s", a.Filename, t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to upload file %s to tracker ID: %d, login %s, URL: %s", a.Filename, t.ID, t.Credentials.Login, t.URL)
+	}
+	return ur.Upload.Token, nil
+}
+
+//IssueRelations returns relations of issue with other issues
+func (r *RestClient) IssueRelations(ctx context.Context, t entities.Tracker, _ entities.ProjectID, issueID entities.IssueID) ([]entities.Relation, error) {
+	var rr relationsRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueRelationsResource(issueID),
+		tracker:            t,
+		result:             &rr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load issue relations by ID %d from tracker ID: %d, URL: %s", issueID, t.ID, t.URL)
+	}
+	return toRelations(rr.Relations), nil
+}
+
+//CreateRelation links rel.IssueID to rel.IssueToID with relation rel.Type
+func (r *RestClient) CreateRelation(ctx context.Context, t entities.Tracker, _ entities.ProjectID, rel entities.Relation) (*entities.Relation, error) {
+	var rr relationRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           issueRelationsResource(rel.IssueID),
+		tracker:            t,
+		result:             &rr,
+		method:             post,
+		body:               toRelationRoot(rel),
+		validateStatusFunc: validateStatusCreated,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrIssueNotFound, "invalid issue ID %d for tracker ID: %d, URL: %s", rel.IssueID, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create relation for issue ID %d for tracker ID: %d, URL: %s", rel.IssueID, t.ID, t.URL)
+	}
+	relation := toRelation(rr.Relation)
+	return &relation, nil
+}
+
+//DeleteRelation removes relation by ID
+func (r *RestClient) DeleteRelation(ctx context.Context, t entities.Tracker, _ entities.ProjectID, id entities.RelationID) error {
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           relationResource(id),
+		tracker:            t,
+		method:             del,
+		validateStatusFunc: validateStatusNoContent,
+	})
+	if err == errNotFound {
+		return errors.Wrapf(entities.ErrRelationNotFound, "invalid relation ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete relation ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	return nil
+}
+
+//ProjectMembers returns users and groups which are members of project pid and total amount of them
+//Maximum paginatation limit is 100 items
+func (r *RestClient) ProjectMembers(ctx context.Context, t entities.Tracker, pid entities.ProjectID, p entities.Pagination) ([]entities.ProjectMember, int64, error) {
+	var mr membershipsRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           projectMembershipsResource(pid, p),
+		tracker:            t,
+		result:             &mr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, 0, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to load members of project ID %d from tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	return toProjectMembers(mr.Memberships), mr.TotalCount, nil
+}
+
+//WikiIndex returns list of project wiki pages
+func (r *RestClient) WikiIndex(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.WikiPageInfo, error) {
+	var wr wikiPagesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           wikiIndexResource(pid),
+		tracker:            t,
+		result:             &wr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d or wiki is disabled for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load wiki index of project ID %d from tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	return toWikiPageInfos(wr.WikiPages), nil
+}
+
+//WikiPage returns version of project wiki page by title, zero version returns current one
+func (r *RestClient) WikiPage(ctx context.Context, t entities.Tracker, pid entities.ProjectID, title string, version int64) (*entities.WikiPage, error) {
+	var wr wikiPageRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           wikiPageResource(pid, title, version),
+		tracker:            t,
+		result:             &wr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrWikiPageNotFound, "invalid wiki page %s version %d of project ID %d for tracker ID: %d, URL: %s", title, version, pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load wiki page %s of project ID %d from tracker ID: %d, URL: %s", title, pid, t.ID, t.URL)
+	}
+	page := toWikiPage(wr.WikiPage)
+	return &page, nil
+}
+
+//Search returns resources matching search query and total amount of them
+//Maximum paginatation limit is 100 items
+func (r *RestClient) Search(ctx context.Context, t entities.Tracker, s entities.SearchQuery, p entities.Pagination) ([]entities.SearchResult, int64, error) {
+	resource, err := searchQueryResource(s, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	var sr searchRoot
+	err = redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           resource,
+		tracker:            t,
+		result:             &sr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound && s.ProjectID != 0 {
+		return nil, 0, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d for tracker ID: %d, URL: %s", s.ProjectID, t.ID, t.URL)
+	}
+	if err == errNotFound {
+		return nil, 0, errors.Wrapf(entities.ErrTrackerURL, "failed to search on tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to search on tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	return toSearchResults(sr.Results), sr.TotalCount, nil
+}
+
+//SavedQueries returns issue queries saved on tracker which are available for project pid.
+//Zero pid returns all visible queries, pages after first one are loaded in parallel
+func (r *RestClient) SavedQueries(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.SavedQuery, error) {
+	first, err := r.queries(ctx, t, entities.Pagination{Limit: queriesPageLimit})
+	if err != nil {
+		return nil, err
+	}
+	limit := int(first.Limit)
+	if limit <= 0 {
+		limit = queriesPageLimit
+	}
+	pages := pagesCount(int(first.TotalCount), limit)
+	if pages <= 1 {
+		return toSavedQueries(first.Queries, pid), nil
+	}
+	queriesByPage := make([][]query, pages)
+	queriesByPage[0] = first.Queries
+	err = loadPages(ctx, pages, func(ctx context.Context, page int) error {
+		qr, err := r.queries(ctx, t, entities.Pagination{Offset: page * limit, Limit: limit})
+		if err != nil {
+			return err
+		}
+		queriesByPage[page] = qr.Queries
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var qs []query
+	for _, q := range queriesByPage {
+		qs = append(qs, q...)
+	}
+	return toSavedQueries(qs, pid), nil
+}
+
+func (r *RestClient) queries(ctx context.Context, t entities.Tracker, p entities.Pagination) (*queriesRoot, error) {
+	var qr queriesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           queriesResource(p),
+		tracker:            t,
+		result:             &qr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrTrackerURL, "failed to load saved queries from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load saved queries from tracker ID: %d, login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	return &qr, nil
+}
+
+//ProjectVersions returns versions available for project issues including shared ones
+func (r *RestClient) ProjectVersions(ctx context.Context, t entities.Tracker, pid entities.ProjectID) ([]entities.Version, error) {
+	var vr versionsRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           projectVersionsResource(pid),
+		tracker:            t,
+		result:             &vr,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load project versions by ID %d from tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	return toVersions(vr.Versions), nil
+}
+
+//CreateVersion creates version for project
+func (r *RestClient) CreateVersion(ctx context.Context, t entities.Tracker, pid entities.ProjectID, v entities.Version) (*entities.Version, error) {
+	var vr versionRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           projectVersionsResource(pid),
+		tracker:            t,
+		result:             &vr,
+		method:             post,
+		body:               toVersionRoot(v),
+		validateStatusFunc: validateStatusCreated,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrProjectNotFound, "invalid project ID %d for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create version for project ID %d for tracker ID: %d, URL: %s", pid, t.ID, t.URL)
+	}
+	version := toVersion(vr.Version)
+	return &version, nil
+}
+
+//TotalReports returns seconds amount for user 1 day for date
+func (r *RestClient) TotalReports(ctx context.Context, t entities.Tracker, date int64) (int64, error) {
+	ts, err := r.allTimeEntries(ctx, t, func(p entities.Pagination) string {
+		return timeEntriesResource(date, p)
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to load total reports from tracker ID %d, user login: %s, date %d", t.ID, t.Credentials.Login, date)
+	}
+	return sumReportHours(ts), nil
+}
+
+//Reports returns user time reports between from and to dates (UNIX timestamps in seconds) inclusive
+//and total amount of them
+func (r *RestClient) Reports(ctx context.Context, t entities.Tracker, from, to int64, p entities.Pagination) ([]entities.Report, int64, error) {
+	var ts timeEntriesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           reportsRangeResource(from, to, p),
+		tracker:            t,
+		result:             &ts,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, 0, errors.Wrapf(entities.ErrTrackerURL, "failed to load reports from tracker ID %d, user login: %s, from %d to %d", t.ID, t.Credentials.Login, from, to)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to load reports from tracker ID %d, user login: %s, from %d to %d", t.ID, t.Credentials.Login, from, to)
+	}
+	return toReports(ts.TimeEntries), int64(ts.TotalCount), nil
+}
+
+//ReportsSummary returns user reported time between from and to dates (UNIX timestamps in seconds) inclusive
+//grouped by day, issue, project and activity
+func (r *RestClient) ReportsSummary(ctx context.Context, t entities.Tracker, from, to int64) (*entities.ReportsSummary, error) {
+	ts, err := r.allTimeEntries(ctx, t, func(p entities.Pagination) string {
+		return reportsRangeResource(from, to, p)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load reports summary from tracker ID %d, user login: %s, from %d to %d", t.ID, t.Credentials.Login, from, to)
+	}
+	s := toReportsSummary(ts)
+	return &s, nil
+}
+
+//allTimeEntries loads every page of time entries returned by resource.
+//First page defines total amount and page size, rest of pages are loaded in parallel.
+//Returns ErrTooManyReports if amount of pages exceeds maxTimeEntriesPages
+func (r *RestClient) allTimeEntries(ctx context.Context, t entities.Tracker, resource func(entities.Pagination) string) ([]timeEntry, error) {
+	first, err := r.timeEntries(ctx, t, resource(entities.Pagination{Limit: timeEntriesPageLimit}))
+	if err != nil {
+		return nil, err
+	}
+	limit := first.Limit
+	if limit <= 0 {
+		limit = timeEntriesPageLimit
+	}
+	pages := pagesCount(first.TotalCount, limit)
+	if pages <= 1 {
+		return first.TimeEntries, nil
+	}
+	if pages > maxTimeEntriesPages {
+		return nil, errors.Wrapf(entities.ErrTooManyReports, "%d time entries exceed limit of %d pages by %d", first.TotalCount, maxTimeEntriesPages, limit)
+	}
+	entriesByPage := make([][]timeEntry, pages)
+	entriesByPage[0] = first.TimeEntries
+	err = loadPages(ctx, pages, func(ctx context.Context, page int) error {
+		ts, err := r.timeEntries(ctx, t, resource(entities.Pagination{Offset: page * limit, Limit: limit}))
+		if err != nil {
+			return err
+		}
+		entriesByPage[page] = ts.TimeEntries
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]timeEntry, 0, first.TotalCount)
+	for _, ts := range entriesByPage {
+		entries = append(entries, ts...)
+	}
+	return entries, nil
+}
+
+func (r *RestClient) timeEntries(ctx context.Context, t entities.Tracker, resource string) (*timeEntriesRoot, error) {
+	var ts timeEntriesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           resource,
+		tracker:            t,
+		result:             &ts,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrTrackerURL, "time entries not found by resource %s", resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+//CreateReport for user and return ID of created report
+func (r *RestClient) CreateReport(ctx context.Context, t entities.Tracker, _ entities.ProjectID, rep entities.Report) (entities.ReportID, error) {
+	var ter timeEntryRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           reportsResource,
+		tracker:            t,
+		result:             &ter,
+		method:             post,
+		body:               reportToTimeEntry(rep),
+		validateStatusFunc: validateStatusCreated,
+	})
+	if err == errNotFound {
+		return 0, errors.Wrapf(entities.ErrIssueNotFound, "invalid issueID %d for tracker ID: %d, URL: %s", rep.IssueID, t.ID, t.URL)
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create report for tracker ID: %d, URL: %s", t.ID, t.URL)
+	}
+	return entities.ReportID(ter.TimeEntry.ID), nil
+}
+
+//UpdateReport updates fields of report rep.ID listed in rep.Fields,
+//without listed fields only issue, activity, comments, duration, date and custom fields with non zero value are updated
+func (r *RestClient) UpdateReport(ctx context.Context, t entities.Tracker, _ entities.ProjectID, rep entities.Report) error {
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           reportResource(rep.ID),
+		tracker:            t,
+		method:             put,
+		body:               toReportParams(rep),
+		validateStatusFunc: validateStatusNoContent,
+	})
+	if err == errNotFound {
+		return errors.Wrapf(entities.ErrReportNotFound, "invalid report ID %d for tracker ID: %d, URL: %s", rep.ID, t.ID, t.URL)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to update report ID %d for tracker ID: %d, URL: %s", rep.ID, t.ID, t.URL)
+	}
+	return nil
+}
+
+//DeleteReport removes report by ID
+func (r *RestClient) DeleteReport(ctx context.Context, t entities.Tracker, _ entities.ProjectID, id entities.ReportID) error {
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		resource:           reportResource(id),
+		tracker:            t,
+		method:             del,
+		validateStatusFunc: validateStatusNoContent,
+	})
+	if err == errNotFound {
+		return errors.Wrapf(entities.ErrReportNotFound, "invalid report ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete report ID %d for tracker ID: %d, URL: %s", id, t.ID, t.URL)
+	}
+	return nil
+}
+
+func sumReportHours(ts []timeEntry) int64 {
+	var totalSeconds int64
+	for _, t := range ts {
+		totalSeconds = totalSeconds + hoursToSeconds(t.Hours)
+	}
+	return totalSeconds
+}
+
+func (r *RestClient) activities(ctx context.Context, t entities.Tracker) (*timeEntryActivitiesRoot, error) {
+	var a timeEntryActivitiesRoot
+	err := redmineRequest(requestOpts{
+		httpClient:         r.httpClient,
+		ctx:                ctx,
+		tracker:            t,
+		resource:           timeEntriesActivities,
+		result:             &a,
+		method:             get,
+		validateStatusFunc: validateStatusOK,
+	})
+	if err == errNotFound {
+		return nil, errors.Wrapf(entities.ErrTrackerURL, "failed to load activities from tracker ID: %d,login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load activities from tracker ID: %d,login %s, URL: %s", t.ID, t.Credentials.Login, t.URL)
+	}
+	return &a, nil
+}
+
+//allProjects loads every page of projects, rest of pages after first one are loaded in parallel.
+//TotalCount is amount of loaded projects as projects could be changed while pages are loaded.
+//Returns ErrTooManyProjects if amount of pages exceeds maxProjectsPages
+func (r *RestClient) allProjects(ctx context.Context, t entities.Tracker, f entities.ProjectFilter) (*projectsRoot, error) {
+	first, err := r.projects(ctx, t, f, entities.Pagination{Limit: projectsPageLimit})
+	if err != nil {
+		return nil, err
+	}
+	limit := int(first.Limit)
+	if limit <= 0 {
+		limit = projectsPageLimit
+	}
+	pages := pagesCount(int(first.TotalCount), limit)
+	if pages <= 1 {
+		first.TotalCount = int64(len(first.Projects))
+		return first, nil
+	}
+	if pages > maxProjectsPages {
+		return nil, errors.Wrapf(entities.ErrTooManyProjects, "%d projects exceed limit of %d pages by %d", first.TotalCount, maxProjectsPages, limit)
+	}
+	projectsByPage := make([][]project, pages)
+	projectsByPage[0] = first.Projects
+	err = loadPages(ctx, pages, func(ctx context.Context, page int) error {
+		pr, err := r.projects(ctx, t, f, entities.Pagination{Offset: page * limit, Limit: limit})
+		if err != nil {
+			return err
+		}
+		projectsByPage[page] = pr.Projects
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var all projectsRoot
+	for _, ps := range projectsByPage {
+		all.Projects = append(all.Projects, ps...)
+	}
+	all.TotalCount = int64(len(all.Projects))
+	return &all, nil
 }
 
-func (r *RestClient) projects(ctx context.Context, t entities.Tracker) (*projectsRoot, error) {
+func (r *RestClient) projects(ctx context.Context, t entities.Tracker, f entities.ProjectFilter, pg entities.Pagination) (*projectsRoot, error) {
 	var p projectsRoot
 	err := redmineRequest(requestOpts{
 		httpClient:         r.httpClient,
 		ctx:                ctx,
 		tracker:            t,
-		resource:           projectsResource,
+		resource:           projectsResource(f, pg),
 		result:             &p,
 		method:             get,
 		validateStatusFunc: validateStatusOK,
@@ -1,6 +1,7 @@
 package redmine
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -31,6 +33,19 @@ const (
 	issueFile          = "issue.json"
 	timeentriesFile    = "timeentries.json"
 	timeActivitiesFile = "timeactivities.json"
+	issueStatusesFile  = "issuestatuses.json"
+	issueJournalsFile  = "issuejournals.json"
+	attachmentsFile    = "issueattachments.json"
+	attachmentFile     = "attachment.json"
+	customFieldsFile   = "customfields.json"
+	versionsFile       = "versions.json"
+	queriesFile        = "queries.json"
+	searchFile         = "search.json"
+	wikiPagesFile      = "wikipages.json"
+	wikiPageFile       = "wikipage.json"
+	membershipsFile    = "memberships.json"
+	prioritiesFile     = "issuepriorities.json"
+	categoriesFile     = "issuecategories.json"
 )
 
 var (
@@ -48,9 +63,12 @@ func TestProjectsReq(t *testing.T) {
 		if r.URL.Path != "/projects.json" {
 			t.Errorf("Invalid resource path %s", r.URL.Path)
 		}
-		if r.URL.Query().Get("include") != "trackers" {
+		if r.URL.Query().Get("include") != "trackers,enabled_modules" {
 			t.Errorf("Missed include query param")
 		}
+		if r.URL.Query().Get("offset") != "25" || r.URL.Query().Get("limit") != "50" {
+			t.Errorf("Invalid pagination %s", r.URL.RawQuery)
+		}
 		w.Write(readTestFile(t, projectsFile))
 	}))
 	defer ts.Close()
@@ -60,7 +78,7 @@ func TestProjectsReq(t *testing.T) {
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
-	})
+	}, entities.ProjectFilter{}, entities.Pagination{Offset: 25, Limit: 50})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -82,6 +100,15 @@ func TestProjectsReq(t *testing.T) {
 		if !reflect.DeepEqual(p.IssueTypes, expectedPr.IssueTypes) {
 			t.Errorf("Invalid issuetypes %v != %v \n %v \n %v", len(p.IssueTypes), len(expectedPr.IssueTypes), p.IssueTypes, expectedPr.IssueTypes)
 		}
+		if p.ParentID != expectedPr.ParentID || p.Identifier != expectedPr.Identifier || p.Status != expectedPr.Status {
+			t.Errorf("Invalid hierarchy %+v", p)
+		}
+		if p.Created != expectedPr.Created || p.Updated != expectedPr.Updated {
+			t.Errorf("Invalid times %v %v", p.Created, p.Updated)
+		}
+		if !reflect.DeepEqual(p.Modules, expectedPr.Modules) {
+			t.Errorf("Invalid modules %v", p.Modules)
+		}
 	}
 }
 
@@ -96,7 +123,7 @@ func TestProjectsReqNotFound(t *testing.T) {
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
-	})
+	}, entities.ProjectFilter{}, entities.Pagination{})
 	assertErr(t, err, entities.ErrTrackerURL)
 }
 
@@ -111,7 +138,7 @@ func TestProjectsReqInternalError(t *testing.T) {
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
-	})
+	}, entities.ProjectFilter{}, entities.Pagination{})
 	assertErr(t, err, entities.ErrRemoteServer)
 }
 
@@ -124,7 +151,7 @@ func TestProjectReq(t *testing.T) {
 		if r.URL.Path != "/projects/"+fmt.Sprintf("%d", pid)+".json" {
 			t.Errorf("Invalid resource path %s", r.URL.Path)
 		}
-		if r.URL.Query().Get("include") != "trackers" {
+		if r.URL.Query().Get("include") != "trackers,issue_custom_fields,enabled_modules" {
 			t.Error("Missed include query param")
 		}
 		w.Write(readTestFile(t, projectFile))
@@ -298,6 +325,230 @@ func TestUserReqInternalErr(t *testing.T) {
 	assertErr(t, err, entities.ErrRemoteServer)
 }
 
+func TestAPIKeyReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != currentUserResourse {
+			t.Errorf("Invalid resource path %s", r.URL.Path)
+		}
+		login, pass, ok := r.BasicAuth()
+		if !ok || login != testCreds.Login || pass != testCreds.Password {
+			t.Errorf("Unexpected basic auth %s %s", login, pass)
+		}
+		w.Write(readTestFile(t, userFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	key, err := r.APIKey(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "620a6dd5aabab4ce74e0e752d85e99163ffac8e4" {
+		t.Errorf("Unexpected API key %s", key)
+	}
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			t.Error("Basic auth should not be sent with API key")
+		}
+		if key := r.Header.Get(apiKeyHeader); key != "620a6dd5aabab4ce74e0e752d85e99163ffac8e4" {
+			t.Errorf("Unexpected API key header %s", key)
+		}
+		w.Write(readTestFile(t, userFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.UserInfo(context.Background(), entities.Tracker{
+		Credentials: entities.Credentials{
+			APIKey: "620a6dd5aabab4ce74e0e752d85e99163ffac8e4",
+		},
+		URL:  ts.URL,
+		Type: redmineType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSwitchUserAuth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get(apiKeyHeader); key != "admin-key" {
+			t.Errorf("Unexpected API key header %s", key)
+		}
+		if login := r.Header.Get(switchUserHeader); login != "yrogov" {
+			t.Errorf("Unexpected switch user header %s", login)
+		}
+		w.Write(readTestFile(t, userFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.UserInfo(context.Background(), entities.Tracker{
+		Credentials: entities.Credentials{
+			APIKey:     "admin-key",
+			SwitchUser: "yrogov",
+		},
+		URL:  ts.URL,
+		Type: redmineType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSwitchUserAuthInvalidUser(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.UserInfo(context.Background(), entities.Tracker{
+		Credentials: entities.Credentials{
+			APIKey:     "admin-key",
+			SwitchUser: "unknown",
+		},
+		URL:  ts.URL,
+		Type: redmineType,
+	})
+	assertErr(t, err, entities.ErrCredentials)
+}
+
+func TestAPIKeyReqInvalidCredentials(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.APIKey(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	})
+	assertErr(t, err, entities.ErrCredentials)
+}
+
+func TestIssueChangesReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != issuesResource {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("updated_on") != ">=2016-06-10T10:13:28Z" ||
+			q.Get("sort") != "updated_on,id" ||
+			q.Get("status_id") != "*" ||
+			q.Get("offset") != "0" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"issues":[
+			{"id":3,"subject":"seen","updated_on":"2016-06-10T10:13:28Z"},
+			{"id":5,"subject":"last seen","updated_on":"2016-06-10T10:13:28Z"},
+			{"id":7,"subject":"same second","updated_on":"2016-06-10T10:13:28Z"},
+			{"id":2,"subject":"later","updated_on":"2016-06-10T10:13:38Z"}
+		],"total_count":4,"offset":0,"limit":100}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+	cursor := entities.IssueCursor{Updated: 1465553608, LastID: 5}
+	is, next, err := r.IssueChanges(context.Background(), tr, cursor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != 2 || is[0].ID != 7 || is[1].ID != 2 {
+		t.Errorf("Unexpected issues %+v", is)
+	}
+	if next != (entities.IssueCursor{Updated: 1465553618, LastID: 2}) {
+		t.Errorf("Unexpected cursor %+v", next)
+	}
+
+	is, next, err = r.IssueChanges(context.Background(), tr, cursor, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != 1 || is[0].ID != 7 {
+		t.Errorf("Unexpected limited issues %+v", is)
+	}
+	if next != (entities.IssueCursor{Updated: 1465553608, LastID: 7}) {
+		t.Errorf("Unexpected limited cursor %+v", next)
+	}
+}
+
+func TestIssueChangesReqSeenPage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var ir issuesRoot
+		ir.TotalCount = 150
+		for i := offset; i < 150 && i < offset+100; i++ {
+			ir.Issues = append(ir.Issues, issue{
+				ID:        int64(i + 1),
+				UpdatedOn: time.Unix(1465553608, 0),
+			})
+		}
+		b, _ := json.Marshal(ir)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+	cursor := entities.IssueCursor{Updated: 1465553608, LastID: 120}
+	is, next, err := r.IssueChanges(context.Background(), tr, cursor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != 30 || is[0].ID != 121 {
+		t.Errorf("Unexpected issues amount %d", len(is))
+	}
+	if next != (entities.IssueCursor{Updated: 1465553608, LastID: 150}) {
+		t.Errorf("Unexpected cursor %+v", next)
+	}
+
+	cursor = next
+	is, next, err = r.IssueChanges(context.Background(), tr, cursor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != 0 || next != cursor {
+		t.Errorf("Unexpected changes %+v, cursor %+v", is, next)
+	}
+}
+
+func TestIssueChangesReqInternalErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, _, err := r.IssueChanges(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.IssueCursor{}, 0)
+	assertErr(t, err, entities.ErrRemoteServer)
+}
+
 func TestParallelIssues(t *testing.T) {
 	ids := []entities.IssueID{1, 2, 3, 4, 5}
 	var counter int64
@@ -328,6 +579,35 @@ func TestParallelIssues(t *testing.T) {
 	}
 }
 
+func TestParallelIssuesOrder(t *testing.T) {
+	ids := []entities.IssueID{5, 3, 1, 4, 2}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/issues/"), ".json"))
+		time.Sleep(time.Duration(id) * time.Millisecond)
+		fmt.Fprintf(w, `{"issue":{"id":%d}}`, id)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	issues, err := r.parallelFullIssues(
+		context.Background(),
+		entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		},
+		ids,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, issue := range issues {
+		if issue.ID != ids[i] {
+			t.Errorf("Unexpected issue %d at %d", issue.ID, i)
+		}
+	}
+}
+
 func TestParallelIssuesErr(t *testing.T) {
 	ids := []entities.IssueID{1, 2, 3, 4, 5}
 	var counter int64
@@ -389,6 +669,8 @@ func TestProjectIssuesReq(t *testing.T) {
 			Type:        redmineType,
 		},
 		pid,
+		entities.IssueFilter{},
+		nil,
 		page,
 	)
 	if err != nil {
@@ -425,6 +707,8 @@ func TestProjectIssuesReqNotFound(t *testing.T) {
 			Type:        redmineType,
 		},
 		pid,
+		entities.IssueFilter{},
+		nil,
 		page,
 	)
 	assertErr(t, err, entities.ErrProjectNotFound)
@@ -450,19 +734,120 @@ func TestProjectIssuesReqInternalErr(t *testing.T) {
 			Type:        redmineType,
 		},
 		pid,
+		entities.IssueFilter{},
+		nil,
 		page,
 	)
 	assertErr(t, err, entities.ErrRemoteServer)
 }
 
 func TestProjectIssueResourceEmptyPage(t *testing.T) {
-	result := projectIssuesResource(1, entities.Pagination{})
+	result, err := projectIssuesResource(1, entities.IssueFilter{}, nil, entities.Pagination{})
 	expected := "/projects/1/issues.json?offset=0&limit=100&assigned_to_id=me"
-	if result != expected {
+	if err != nil || result != expected {
 		t.Error("Unexpected result", result)
 	}
 }
 
+func TestProjectIssuesResourceFilter(t *testing.T) {
+	tests := map[string]struct {
+		filter   entities.IssueFilter
+		sort     []entities.IssueSort
+		expected url.Values
+		err      error
+	}{
+		"Default": {
+			expected: url.Values{"assigned_to_id": {"me"}},
+		},
+		"All team issues": {
+			filter: entities.IssueFilter{
+				Status:       entities.IssueStatusAll,
+				TrackerIDs:   []int64{1, 19},
+				Assignee:     entities.AssigneeAny,
+				VersionID:    925,
+				UpdatedSince: 1465862400,
+				CreatedSince: 1465776000,
+				Subject:      "rest client",
+			},
+			sort: []entities.IssueSort{
+				{Field: "priority", Desc: true},
+				{Field: "id"},
+			},
+			expected: url.Values{
+				"status_id":        {"*"},
+				"tracker_id":       {"1|19"},
+				"fixed_version_id": {"925"},
+				"updated_on":       {">=2016-06-14T00:00:00Z"},
+				"created_on":       {">=2016-06-13T00:00:00Z"},
+				"subject":          {"~rest client"},
+				"sort":             {"priority:desc,id"},
+			},
+		},
+		"Closed unassigned": {
+			filter: entities.IssueFilter{
+				Status:   entities.IssueStatusClosed,
+				Assignee: entities.AssigneeNone,
+			},
+			expected: url.Values{
+				"status_id":      {"closed"},
+				"assigned_to_id": {"!*"},
+			},
+		},
+		"Specific user": {
+			filter: entities.IssueFilter{
+				Assignee:   entities.AssigneeUser,
+				AssigneeID: 1131,
+			},
+			expected: url.Values{"assigned_to_id": {"1131"}},
+		},
+		"User without ID": {
+			filter: entities.IssueFilter{Assignee: entities.AssigneeUser},
+			err:    entities.ErrIssueFilter,
+		},
+		"Unknown status": {
+			filter: entities.IssueFilter{Status: "resolved"},
+			err:    entities.ErrIssueFilter,
+		},
+		"Saved query": {
+			filter: entities.IssueFilter{
+				QueryID: 12,
+				Status:  entities.IssueStatusAll,
+			},
+			sort:     []entities.IssueSort{{Field: "id", Desc: true}},
+			expected: url.Values{"query_id": {"12"}, "sort": {"id:desc"}},
+		},
+		"Empty sort field": {
+			sort: []entities.IssueSort{{Desc: true}},
+			err:  entities.ErrIssueFilter,
+		},
+	}
+	for label, test := range tests {
+		resource, err := projectIssuesResource(1, test.filter, test.sort, entities.Pagination{Offset: 5, Limit: 10})
+		if test.err != nil {
+			if errors.Cause(err) != test.err {
+				t.Errorf("Test %s unexpected error %v", label, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test %s unexpected error %v", label, err)
+		}
+		u, err := url.Parse(resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q := u.Query()
+		if q.Get("offset") != "5" || q.Get("limit") != "10" {
+			t.Errorf("Test %s invalid pagination %s", label, u.RawQuery)
+		}
+		q.Del("offset")
+		q.Del("limit")
+		if !reflect.DeepEqual(test.expected, q) {
+			t.Errorf("Test %s unexpected query %v", label, q)
+		}
+	}
+}
+
 func TestIssueByIDReq(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != get {
@@ -471,6 +856,9 @@ func TestIssueByIDReq(t *testing.T) {
 		if r.URL.Path != "/issues/3.json" {
 			t.Errorf("Unexpected resource path %s", r.URL.Path)
 		}
+		if r.URL.Query().Get("include") != "relations,children" {
+			t.Error("Missed include query param")
+		}
 		w.Write(readTestFile(t, issueFile))
 	}))
 	defer ts.Close()
@@ -533,6 +921,7 @@ func TestIssueReqInternalErr(t *testing.T) {
 func TestCreateIssueReq(t *testing.T) {
 	var newIssueID entities.IssueID = 555
 	testIssue := entities.NewIssue{Issue: *issueJSON}
+	testIssue.Assignee = nil
 	pid := entities.ProjectID(3)
 	uid := int64(3)
 
@@ -543,22 +932,25 @@ func TestCreateIssueReq(t *testing.T) {
 		if r.URL.Path != "/projects/"+strconv.Itoa(int(pid))+"/issues.json" {
 			t.Errorf("Unexpected resource path %s", r.URL.Path)
 		}
-		var ir issueRoot
+		var ir issueParamsRoot
 		unmarshal(t, r.Body, &ir)
-		if ir.Issue.Subject != testIssue.Title {
-			t.Errorf("Invalid subject %v != %v", ir.Issue.Subject, testIssue.Title)
+		if ir.Issue["subject"] != testIssue.Title {
+			t.Errorf("Invalid subject %v != %v", ir.Issue["subject"], testIssue.Title)
+		}
+		if ir.Issue["due_date"] != "2016-06-14" {
+			t.Errorf("Invalid duedate %v", ir.Issue["due_date"])
 		}
-		if ir.Issue.DueDate != "2016-06-14" {
-			t.Errorf("Invalid duedate %v", ir.Issue.DueDate)
+		if ir.Issue["estimated_hours"] != 24.0 {
+			t.Errorf("Invalid estimate hours %v", ir.Issue["estimated_hours"])
 		}
-		if ir.Issue.EstimatedHours != 24.0 {
-			t.Errorf("Invalid estimate hours %v", ir.Issue.EstimatedHours)
+		if ir.Issue["assigned_to_id"] != float64(uid) {
+			t.Errorf("Invalid userID %v != %v", ir.Issue["assigned_to_id"], uid)
 		}
-		if ir.Issue.AssignedToID != uid {
-			t.Errorf("Invalid userID %v != %v", ir.Issue.AssignedToID, uid)
+		if ir.Issue["parent_issue_id"] != float64(testIssue.ParentID) {
+			t.Errorf("Invalid parent issue ID %v", ir.Issue["parent_issue_id"])
 		}
 		w.WriteHeader(http.StatusCreated)
-		ir.Issue.ID = int64(newIssueID)
+		ir.Issue["id"] = int64(newIssueID)
 		b, _ := json.Marshal(ir)
 		w.Write(b)
 	}))
@@ -641,6 +1033,9 @@ func TestCreateReportReq(t *testing.T) {
 		IssueID:    1,
 		Started:    1470839302,
 		ActivityID: 9,
+		CustomFields: []entities.CustomField{
+			{ID: 29, Values: []string{"Overtime"}},
+		},
 	}
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != post {
@@ -656,12 +1051,18 @@ func TestCreateReportReq(t *testing.T) {
 			timeEntryRoot.TimeEntry.SpentOn != "2016-08-10" {
 			t.Error("Iinvalid timeEntry")
 		}
+		if !reflect.DeepEqual(timeEntryRoot.TimeEntry.CustomFields, []customField{{ID: 29, Value: "Overtime"}}) {
+			t.Errorf("Invalid custom fields %+v", timeEntryRoot.TimeEntry.CustomFields)
+		}
+		timeEntryRoot.TimeEntry.ID = 219540
 		w.WriteHeader(http.StatusCreated)
+		b, _ := json.Marshal(timeEntryRoot)
+		w.Write(b)
 	}))
 	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	err := r.CreateReport(context.Background(), entities.Tracker{
+	id, err := r.CreateReport(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
@@ -669,6 +1070,9 @@ func TestCreateReportReq(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if id != 219540 {
+		t.Errorf("Unexpected report ID %d", id)
+	}
 }
 
 func TestCreateReportReqNotFound(t *testing.T) {
@@ -684,7 +1088,7 @@ func TestCreateReportReqNotFound(t *testing.T) {
 	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	err := r.CreateReport(context.Background(), entities.Tracker{
+	_, err := r.CreateReport(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
@@ -705,7 +1109,7 @@ func TestCreateReportReqInternalErr(t *testing.T) {
 	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	err := r.CreateReport(context.Background(), entities.Tracker{
+	_, err := r.CreateReport(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
@@ -877,6 +1281,81 @@ func TestTotalReportsReqInternalErr(t *testing.T) {
 	assertErr(t, err, entities.ErrRemoteServer)
 }
 
+func TestTotalReportsReqPages(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		q := r.URL.Query()
+		if q.Get("spent_on") != "2016-06-14" || q.Get("limit") != "100" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		var tr timeEntriesRoot
+		for i := offset; i < 250 && i < offset+100; i++ {
+			tr.TimeEntries = append(tr.TimeEntries, timeEntry{ID: int64(i), Hours: 0.5})
+		}
+		tr.TotalCount = 250
+		tr.Offset = offset
+		tr.Limit = 100
+		b, _ := json.Marshal(tr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	total, err := r.TotalReports(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465862400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 250*30*60 {
+		t.Errorf("Unexpected total %d", total)
+	}
+	if atomic.LoadInt32(&requests) != 3 {
+		t.Errorf("Unexpected amount of requests %d", requests)
+	}
+}
+
+func TestTotalReportsReqTooManyPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			t.Errorf("Unexpected page request %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(fmt.Sprintf(`{"time_entries":[],"total_count":%d,"offset":0,"limit":100}`, maxTimeEntriesPages*100+1)))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.TotalReports(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465862400)
+	assertErr(t, err, entities.ErrTooManyReports)
+}
+
+func TestTotalReportsReqPageErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "100" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"time_entries":[{"id":1,"hours":1.0}],"total_count":150,"offset":0,"limit":100}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.TotalReports(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465862400)
+	assertErr(t, err, entities.ErrRemoteServer)
+}
+
 func TestUpdateIssueProgress(t *testing.T) {
 	is := entities.IssueID(3)
 	prog := entities.Progress(4)
@@ -911,6 +1390,32 @@ func TestUpdateIssueProgress(t *testing.T) {
 	}
 }
 
+func TestUpdateIssueProgressZero(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]interface{}
+		unmarshal(t, r.Body, &body)
+		expected := map[string]interface{}{
+			"id":         float64(3),
+			"done_ratio": float64(0),
+		}
+		if !reflect.DeepEqual(expected, body["issue"]) {
+			t.Errorf("Invalid issue passed to the server %v", body["issue"])
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.UpdateIssueProgress(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestUpdateIssueProgressError(t *testing.T) {
 	is := entities.IssueID(3)
 	prog := entities.Progress(4)
@@ -1144,7 +1649,7 @@ func TestProjectIssuesErr(t *testing.T) {
 	}
 
 	r := NewClient(testTimeout())
-	_, _, err := r.ProjectIssues(context.Background(), tr, 1, page)
+	_, _, err := r.ProjectIssues(context.Background(), tr, 1, entities.IssueFilter{}, nil, page)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -1173,7 +1678,7 @@ func TestProjectIssuesErrorAsync(t *testing.T) {
 	}
 
 	r := NewClient(testTimeout())
-	_, _, err := r.ProjectIssues(context.Background(), tr, 1, page)
+	_, _, err := r.ProjectIssues(context.Background(), tr, 1, entities.IssueFilter{}, nil, page)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -1202,7 +1707,7 @@ func TestProjectIssues(t *testing.T) {
 	}
 
 	r := NewClient(testTimeout())
-	iss, _, err := r.ProjectIssues(context.Background(), tr, 1, page)
+	iss, _, err := r.ProjectIssues(context.Background(), tr, 1, entities.IssueFilter{}, nil, page)
 	if len(iss) == 0 {
 		t.Error("Should return issues")
 	}
@@ -1234,7 +1739,7 @@ func TestProjects(t *testing.T) {
 	}
 
 	r := NewClient(testTimeout())
-	prs, _, err := r.Projects(context.Background(), tr, page)
+	prs, _, err := r.Projects(context.Background(), tr, entities.ProjectFilter{}, page)
 	if len(prs) == 0 {
 		t.Error("Should return projects")
 	}
@@ -1243,127 +1748,2653 @@ func TestProjects(t *testing.T) {
 	}
 }
 
-func TestProjectActivityErr(t *testing.T) {
+func TestProjectsAll(t *testing.T) {
+	var requests int32
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/project") {
-			w.Write(readTestFile(t, projectFile))
-		}
-		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
-			w.WriteHeader(http.StatusInternalServerError)
+		if r.URL.Path == timeEntriesActivities {
+			w.Write([]byte(`{"time_entry_activities":[]}`))
+			return
 		}
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/projects.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		pr := projectsRoot{
+			TotalCount: 230,
+			Offset:     int64(offset),
+			Limit:      100,
+		}
+		for i := offset; i < 230 && i < offset+100; i++ {
+			pr.Projects = append(pr.Projects, project{ID: int64(i + 1)})
+		}
+		b, _ := json.Marshal(pr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	prs, amount, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{}, entities.Pagination{Offset: 95, Limit: 10, All: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 230 {
+		t.Errorf("Unexpected amount %d", amount)
+	}
+	if atomic.LoadInt32(&requests) != 3 {
+		t.Errorf("Unexpected amount of requests %d", requests)
+	}
+	if len(prs) != 10 {
+		t.Fatalf("Unexpected amount of projects %d", len(prs))
+	}
+	for i, p := range prs {
+		if p.ID != entities.ProjectID(96+i) {
+			t.Errorf("Unexpected project %d at %d", p.ID, i)
+		}
+	}
+}
+
+func TestProjectsAllChangedTotal(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == timeEntriesActivities {
+			w.Write([]byte(`{"time_entry_activities":[]}`))
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// Projects were removed after first page had been loaded
+		pr := projectsRoot{
+			TotalCount: 230,
+			Offset:     int64(offset),
+			Limit:      100,
+		}
+		if offset > 0 {
+			pr.TotalCount = 120
+		}
+		for i := offset; i < int(pr.TotalCount) && i < offset+100; i++ {
+			pr.Projects = append(pr.Projects, project{ID: int64(i + 1)})
+		}
+		b, _ := json.Marshal(pr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	prs, amount, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{}, entities.Pagination{All: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 120 || len(prs) != 120 {
+		t.Errorf("Unexpected amount %d of %d projects", amount, len(prs))
+	}
+}
+
+func TestProjectsAllTooManyPages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			t.Error("Should not load rest of pages")
+		}
+		w.Write([]byte(fmt.Sprintf(`{"projects":[],"total_count":%d,"offset":0,"limit":100}`, maxProjectsPages*100+1)))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{}, entities.Pagination{All: true})
+	assertErr(t, err, entities.ErrTooManyProjects)
+}
+
+func TestProjectsActiveOnly(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == timeEntriesActivities {
+			w.Write([]byte(`{"time_entry_activities":[]}`))
+			return
+		}
+		if r.URL.Query().Get("status") != "1" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readTestFile(t, projectsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	prs, amount, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{ActiveOnly: true}, entities.Pagination{All: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 4 || len(prs) != 4 {
+		t.Fatalf("Unexpected amount of projects %d %d", amount, len(prs))
+	}
+	for _, p := range prs {
+		if p.Status != entities.ProjectStatusActive {
+			t.Errorf("Unexpected project %+v", p)
+		}
+	}
+}
+
+func TestProjectsActiveOnlyPage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == timeEntriesActivities {
+			w.Write([]byte(`{"time_entry_activities":[]}`))
+			return
+		}
+		if r.URL.Query().Get("offset") != "0" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		// Trackers before 4.1 return projects of all statuses
+		w.Write(readTestFile(t, projectsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	prs, amount, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{ActiveOnly: true}, entities.Pagination{Offset: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 4 || len(prs) != 2 {
+		t.Fatalf("Unexpected amount %d of %d projects", amount, len(prs))
+	}
+	for _, p := range prs {
+		if p.Status != entities.ProjectStatusActive {
+			t.Errorf("Unexpected project %+v", p)
+		}
+	}
+}
+
+func TestProjectTree(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == timeEntriesActivities {
+			w.Write([]byte(`{"time_entry_activities":[]}`))
+			return
+		}
+		if r.URL.Path != "/projects.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, projectsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	tree, err := r.ProjectTree(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := func(ns []entities.ProjectNode) []entities.ProjectID {
+		var ids []entities.ProjectID
+		for _, n := range ns {
+			ids = append(ids, n.ID)
+		}
+		return ids
+	}
+	if !reflect.DeepEqual([]entities.ProjectID{170, 379, 987}, ids(tree)) {
+		t.Fatalf("Unexpected top level projects %v", ids(tree))
+	}
+	if !reflect.DeepEqual([]entities.ProjectID{577, 223}, ids(tree[0].Children)) {
+		t.Errorf("Unexpected subprojects %v", ids(tree[0].Children))
+	}
+	if tree[0].Link != ts.URL+"/projects/170" {
+		t.Errorf("Unexpected link %s", tree[0].Link)
+	}
+}
+
+func TestProjectTreeParentFiltered(t *testing.T) {
+	ps := []entities.Project{
+		{ID: 1, ParentID: 5},
+		{ID: 2, ParentID: 1},
+	}
+	tree := toProjectTree(ps)
+	if len(tree) != 1 || tree[0].ID != 1 {
+		t.Fatalf("Unexpected tree %+v", tree)
+	}
+	if len(tree[0].Children) != 1 || tree[0].Children[0].ID != 2 {
+		t.Errorf("Unexpected subprojects %+v", tree[0].Children)
+	}
+}
+
+func TestProjectsAllErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"projects":[],"total_count":150,"offset":0,"limit":100}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Projects(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.ProjectFilter{}, entities.Pagination{All: true})
+	assertErr(t, err, entities.ErrRemoteServer)
+}
+
+func TestProjectActivityErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/project") {
+			w.Write(readTestFile(t, projectFile))
+		}
+		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	_, err := r.Project(context.Background(), tr, 1)
+	if err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProjectsErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	page := entities.Pagination{
+		Offset: 41,
+		Limit:  88,
+	}
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Projects(context.Background(), tr, entities.ProjectFilter{}, page)
+	if err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/project") {
+			w.Write(readTestFile(t, projectFile))
+		}
+		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
+			w.Write(readTestFile(t, timeentriesFile))
+		}
+		if r.URL.Path == customFieldsResource {
+			w.Write(readTestFile(t, customFieldsFile))
+		}
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	pr, err := r.Project(context.Background(), tr, 1)
+	if pr == nil {
+		t.Error("Should return project")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(projectCustomFieldsJSON, pr.CustomFields) {
+		t.Errorf("Unexpected custom fields %+v", pr.CustomFields)
+	}
+}
+
+func TestProjectByURL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/projects") {
+			if r.URL.Path != "/projects/internal.json" {
+				t.Errorf("Invalid resource path %s", r.URL.Path)
+			}
+			w.Write(readTestFile(t, projectFile))
+		}
+		if r.URL.Path == timeEntriesActivities {
+			w.Write(readTestFile(t, timeActivitiesFile))
+		}
+		if r.URL.Path == customFieldsResource {
+			w.Write(readTestFile(t, customFieldsFile))
+		}
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	pr, err := r.ProjectByURL(context.Background(), tr, entities.ProjectURL(ts.URL+"/projects/internal/issues"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.ID != 170 || pr.Link != ts.URL+"/projects/170" {
+		t.Errorf("Unexpected project %+v", pr)
+	}
+	if !reflect.DeepEqual(projectCustomFieldsJSON, pr.CustomFields) {
+		t.Errorf("Unexpected custom fields %+v", pr.CustomFields)
+	}
+}
+
+func TestProjectByIdentifierNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	_, err := r.ProjectByIdentifier(context.Background(), tr, "mobile-app")
+	assertErr(t, err, entities.ErrProjectNotFound)
+	_, err = r.ProjectByIdentifier(context.Background(), tr, "../users/current")
+	assertErr(t, err, entities.ErrProjectNotFound)
+	_, err = r.ProjectByURL(context.Background(), tr, "/issues/1")
+	assertErr(t, err, entities.ErrProjectURL)
+}
+
+func TestProjectCustomFieldsForbidden(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/project") {
+			w.Write(readTestFile(t, projectFile))
+		}
+		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
+			w.Write(readTestFile(t, timeActivitiesFile))
+		}
+		if r.URL.Path == customFieldsResource {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	pr, err := r.Project(context.Background(), tr, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []entities.CustomFieldDefinition{
+		{ID: 79, Name: "Assessment"},
+		{ID: 81, Name: "Components"},
+	}
+	if !reflect.DeepEqual(expected, pr.CustomFields) {
+		t.Errorf("Unexpected custom fields %+v", pr.CustomFields)
+	}
+}
+
+func TestProjectsActivityErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/projects") {
+			w.Write(readTestFile(t, projectsFile))
+		}
+		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	page := entities.Pagination{
+		Offset: 41,
+		Limit:  88,
+	}
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Projects(context.Background(), tr, entities.ProjectFilter{}, page)
+	if err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProjectErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+
+	r := NewClient(testTimeout())
+	_, err := r.Project(context.Background(), tr, 1)
+	if err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIssueStatusesReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != issueStatusesResource {
+			t.Errorf("Invalid resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, issueStatusesFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	statuses, err := r.IssueStatuses(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(issueStatusesJSON, statuses) {
+		t.Errorf("Unexpected result %+v", statuses)
+	}
+}
+
+func TestIssueStatusesReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.IssueStatuses(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	})
+	assertErr(t, err, entities.ErrTrackerURL)
+}
+
+func TestUpdateIssueStatusReq(t *testing.T) {
+	is := entities.IssueID(3)
+	statusID := int64(5)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != put {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/"+strconv.Itoa(int(is))+".json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		var ir issueParamsRoot
+		unmarshal(t, r.Body, &ir)
+		if ir.Issue["id"] != float64(is) ||
+			ir.Issue["status_id"] != float64(statusID) ||
+			ir.Issue["done_ratio"] != nil {
+			t.Error("Invalid issue passed to the server")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.UpdateIssueStatus(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, is, statusID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateIssueStatusReqNoContent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.UpdateIssueStatus(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateIssueStatusReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.UpdateIssueStatus(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 3, 5)
+	assertErr(t, err, entities.ErrIssueNotFound)
+}
+
+func TestCloseIssueReq(t *testing.T) {
+	var closed bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/issues/71307.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		switch {
+		case r.Method == get && r.URL.Query().Get("include") == "allowed_statuses":
+			w.Write([]byte(`{"issue":{"id":71307,"allowed_statuses":[{"id":2,"name":"In Progress"},{"id":6,"name":"Rejected","is_closed":true}]}}`))
+		case r.Method == put:
+			var ir issueParamsRoot
+			unmarshal(t, r.Body, &ir)
+			if ir.Issue["status_id"] != 6.0 {
+				t.Errorf("Unexpected status %v", ir.Issue["status_id"])
+			}
+			closed = true
+		case r.Method == get:
+			w.Write(readTestFile(t, issueFile))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	i, err := r.CloseIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !closed {
+		t.Error("Issue status was not updated")
+	}
+	i.URL = ""
+	if !reflect.DeepEqual(issueJSON, i) {
+		t.Errorf("Unexpected result %+v", i)
+	}
+}
+
+func TestCloseIssueReqStatusesFallback(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/issue_statuses.json":
+			w.Write(readTestFile(t, issueStatusesFile))
+		case r.Method == put:
+			var ir issueParamsRoot
+			unmarshal(t, r.Body, &ir)
+			if ir.Issue["status_id"] != 5.0 {
+				t.Errorf("Unexpected status %v", ir.Issue["status_id"])
+			}
+		default:
+			w.Write(readTestFile(t, issueFile))
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CloseIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCloseIssueReqNoClosedStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		w.Write([]byte(`{"issue":{"id":71307,"allowed_statuses":[{"id":2,"name":"In Progress"}]}}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CloseIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	assertErr(t, err, entities.ErrNoClosedStatus)
+}
+
+func TestDeleteIssueReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != del {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/71307.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.DeleteIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteIssueReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.DeleteIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	assertErr(t, err, entities.ErrIssueNotFound)
+}
+
+const (
+	targetProjectJSON    = `{"project":{"id":300,"name":"Support","identifier":"support","trackers":[{"id":1,"name":"Bug"},{"id":2,"name":"Feature"}]}}`
+	targetCategoriesJSON = `{"issue_categories":[{"id":40,"project":{"id":300,"name":"Support"},"name":"backend"}],"total_count":1}`
+	targetMembersJSON    = `{"memberships":[{"id":3001,"project":{"id":300,"name":"Support"},"user":{"id":1131,"name":"Prylutskyi, Anatolii"},"roles":[{"id":4,"name":"Developer"}]}],"total_count":1,"offset":0,"limit":100}`
+	otherMembersJSON     = `{"memberships":[{"id":3002,"project":{"id":300,"name":"Support"},"user":{"id":1200,"name":"Support Agent"},"roles":[{"id":4,"name":"Developer"}]}],"total_count":1,"offset":0,"limit":100}`
+)
+
+func TestMoveIssueReq(t *testing.T) {
+	tests := map[string]struct {
+		members  string
+		versions string
+		assignee interface{}
+		version  interface{}
+	}{
+		"Member assignee and shared version are kept": {
+			members:  targetMembersJSON,
+			versions: `{"versions":[{"id":925,"project":{"id":223,"name":"TimeGuard"},"name":"Stand alone timeguard","sharing":"system"}]}`,
+			assignee: float64(1131),
+			version:  float64(925),
+		},
+		"Version is remapped by name": {
+			members:  targetMembersJSON,
+			versions: `{"versions":[{"id":950,"project":{"id":300,"name":"Support"},"name":"stand alone Timeguard","sharing":"none"}]}`,
+			assignee: float64(1131),
+			version:  float64(950),
+		},
+		"Non member assignee and unknown version are cleared": {
+			members:  otherMembersJSON,
+			versions: `{"versions":[]}`,
+			assignee: nil,
+			version:  nil,
+		},
+	}
+	for label, test := range tests {
+		var moved bool
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/projects/300.json":
+				w.Write([]byte(targetProjectJSON))
+			case r.URL.Path == "/projects/300/issue_categories.json":
+				w.Write([]byte(targetCategoriesJSON))
+			case r.URL.Path == "/projects/300/memberships.json":
+				w.Write([]byte(test.members))
+			case r.URL.Path == "/projects/300/versions.json":
+				w.Write([]byte(test.versions))
+			case r.URL.Path == "/issues/71307.json" && r.Method == put:
+				var ir issueParamsRoot
+				unmarshal(t, r.Body, &ir)
+				expected := issueParams{
+					"id":               float64(71307),
+					"project_id":       float64(300),
+					"tracker_id":       float64(1),
+					"category_id":      float64(40),
+					"assigned_to_id":   test.assignee,
+					"fixed_version_id": test.version,
+				}
+				if !reflect.DeepEqual(expected, ir.Issue) {
+					t.Errorf("Test %s invalid issue passed to the server %v", label, ir.Issue)
+				}
+				moved = true
+			case r.URL.Path == "/issues/71307.json":
+				w.Write(readTestFile(t, issueFile))
+			default:
+				t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			}
+		}))
+
+		r := NewClient(testTimeout())
+		_, err := r.MoveIssue(context.Background(), entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		}, 223, 71307, 300)
+		if err != nil {
+			t.Errorf("Test %s unexpected error %v", label, err)
+		}
+		if !moved {
+			t.Errorf("Test %s issue was not moved", label)
+		}
+		ts.Close()
+	}
+}
+
+func TestMoveIssueReqProjectNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/issues/71307.json" {
+			w.Write(readTestFile(t, issueFile))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.MoveIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, 71307, 300)
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestCopyIssueReq(t *testing.T) {
+	subtask := `{"issue":{"id":71310,"project":{"id":223,"name":"TimeGuard"},"tracker":{"id":2,"name":"Feature"},"subject":"Implement projects API","children":[{"id":71312,"tracker":{"id":1,"name":"Bug"},"subject":"Fix projects pagination"}]}}`
+	nestedSubtask := `{"issue":{"id":71312,"project":{"id":223,"name":"TimeGuard"},"tracker":{"id":1,"name":"Bug"},"subject":"Fix projects pagination"}}`
+	var created []issueParams
+	var linked, uploaded bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/projects/300.json":
+			w.Write([]byte(targetProjectJSON))
+		case r.URL.Path == "/projects/300/issue_categories.json":
+			w.Write([]byte(targetCategoriesJSON))
+		case r.URL.Path == "/projects/300/memberships.json":
+			w.Write([]byte(otherMembersJSON))
+		case r.URL.Path == "/users/current.json":
+			w.Write(readTestFile(t, userFile))
+		case r.URL.Path == "/projects/300/issues.json":
+			var ir issueParamsRoot
+			unmarshal(t, r.Body, &ir)
+			created = append(created, ir.Issue)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"issue":{"id":%d}}`, 100+len(created))
+		case r.URL.Path == "/issues/71307/relations.json":
+			var rr relationRoot
+			unmarshal(t, r.Body, &rr)
+			if rr.Relation.IssueToID != 101 || rr.Relation.RelationType != "copied_to" {
+				t.Errorf("Invalid relation passed to the server %+v", rr.Relation)
+			}
+			linked = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"relation":{"id":1900,"issue_id":71307,"issue_to_id":101,"relation_type":"copied_to"}}`))
+		case r.URL.Path == "/issues/71307.json" && r.URL.Query().Get("include") == "attachments":
+			w.Write(readTestFile(t, attachmentsFile))
+		case r.URL.Query().Get("include") == "attachments":
+			w.Write([]byte(`{"issue":{"id":71310}}`))
+		case r.URL.Path == "/attachments/4021.json":
+			w.Write(readTestFile(t, attachmentFile))
+		case r.URL.Path == "/attachments/download/4021":
+			w.Write([]byte("png"))
+		case r.URL.Path == "/uploads.json":
+			uploaded = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"upload":{"token":"7167.ed1ccdb093229ca1bd0b043618d88743"}}`))
+		case r.URL.Path == "/issues/71310.json":
+			w.Write([]byte(subtask))
+		case r.URL.Path == "/issues/71312.json":
+			w.Write([]byte(nestedSubtask))
+		case r.URL.Path == "/issues/71307.json" || r.URL.Path == "/issues/101.json":
+			w.Write(readTestFile(t, issueFile))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CopyIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, 71307, entities.IssueCopy{
+		ProjectID:   300,
+		Subtasks:    true,
+		Attachments: true,
+		Link:        true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !linked || !uploaded {
+		t.Error("Copy is not linked or attachments are not uploaded")
+	}
+	if len(created) != 3 {
+		t.Fatalf("Unexpected amount of created issues %d", len(created))
+	}
+	if created[0]["subject"] != "Develop Redmine Tracker Adapter MS" ||
+		created[0]["tracker_id"] != float64(1) ||
+		created[0]["category_id"] != float64(40) ||
+		created[0]["parent_issue_id"] != nil ||
+		created[0]["status_id"] != nil ||
+		created[0]["uploads"] == nil {
+		t.Errorf("Invalid issue copy %v", created[0])
+	}
+	if assignee, ok := created[0]["assigned_to_id"]; !ok || assignee != nil {
+		t.Errorf("Assignee who is not a member of project is copied %v", assignee)
+	}
+	if created[1]["parent_issue_id"] != float64(101) || created[1]["tracker_id"] != float64(2) {
+		t.Errorf("Invalid subtask copy %v", created[1])
+	}
+	if created[2]["parent_issue_id"] != float64(102) || created[2]["tracker_id"] != float64(1) {
+		t.Errorf("Invalid nested subtask copy %v", created[2])
+	}
+}
+
+func TestCopyIssueReqRemovePartialCopy(t *testing.T) {
+	subtask := `{"issue":{"id":71310,"project":{"id":223,"name":"TimeGuard"},"tracker":{"id":2,"name":"Feature"},"subject":"Implement projects API"}}`
+	var removed bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/projects/300.json":
+			w.Write([]byte(targetProjectJSON))
+		case r.URL.Path == "/projects/300/issue_categories.json":
+			w.Write([]byte(targetCategoriesJSON))
+		case r.URL.Path == "/projects/300/memberships.json":
+			w.Write([]byte(targetMembersJSON))
+		case r.URL.Path == "/users/current.json":
+			w.Write(readTestFile(t, userFile))
+		case r.URL.Path == "/projects/300/issues.json":
+			var ir issueParamsRoot
+			unmarshal(t, r.Body, &ir)
+			if ir.Issue["parent_issue_id"] != nil {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"errors":["Assignee is invalid"]}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"issue":{"id":101}}`))
+		case r.URL.Path == "/issues/101.json" && r.Method == del:
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/issues/71310.json":
+			w.Write([]byte(subtask))
+		case r.URL.Path == "/issues/71307.json":
+			w.Write(readTestFile(t, issueFile))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CopyIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, 71307, entities.IssueCopy{
+		ProjectID: 300,
+		Subtasks:  true,
+	})
+	if err == nil {
+		t.Fatal("Should return err")
+	}
+	if !removed {
+		t.Error("Partial copy is not removed")
+	}
+}
+
+func TestCopyIssueReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/300.json":
+			w.Write([]byte(targetProjectJSON))
+		case "/projects/300/issue_categories.json":
+			w.Write([]byte(targetCategoriesJSON))
+		case "/projects/300/memberships.json":
+			w.Write([]byte(targetMembersJSON))
+		case "/users/current.json":
+			w.Write(readTestFile(t, userFile))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CopyIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, 71307, entities.IssueCopy{ProjectID: 300})
+	assertErr(t, err, entities.ErrIssueNotFound)
+}
+
+func TestCopyIssueReqAttachmentTooLarge(t *testing.T) {
+	attachments := fmt.Sprintf(`{"issue":{"id":71307,"attachments":[{"id":4021,"filename":"dump.sql","filesize":%d}]}}`, maxAttachmentSize+1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/projects/300.json":
+			w.Write([]byte(targetProjectJSON))
+		case r.URL.Path == "/projects/300/issue_categories.json":
+			w.Write([]byte(targetCategoriesJSON))
+		case r.URL.Path == "/projects/300/memberships.json":
+			w.Write([]byte(targetMembersJSON))
+		case r.URL.Path == "/users/current.json":
+			w.Write(readTestFile(t, userFile))
+		case r.URL.Path == "/issues/71307.json" && r.URL.Query().Get("include") == "attachments":
+			w.Write([]byte(attachments))
+		case r.URL.Path == "/issues/71307.json":
+			w.Write(readTestFile(t, issueFile))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CopyIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, 71307, entities.IssueCopy{
+		ProjectID:   300,
+		Attachments: true,
+	})
+	assertErr(t, err, entities.ErrAttachmentTooLarge)
+}
+
+func TestIssueCommentsReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/71307.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include") != "journals" {
+			t.Error("Missed include query param")
+		}
+		w.Write(readTestFile(t, issueJournalsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	comments, err := r.IssueComments(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(issueCommentsJSON, comments) {
+		t.Errorf("Unexpected result %+v", comments)
+	}
+}
+
+func TestIssueCommentsReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.IssueComments(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	assertErr(t, err, entities.ErrIssueNotFound)
+}
+
+func TestAddIssueCommentReq(t *testing.T) {
+	is := entities.IssueID(3)
+	comment := entities.Comment{
+		Text:    "Fixed typo",
+		Private: true,
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != put {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/"+strconv.Itoa(int(is))+".json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		var ir issueRoot
+		unmarshal(t, r.Body, &ir)
+		if ir.Issue.Notes != comment.Text ||
+			ir.Issue.PrivateNotes != comment.Private ||
+			ir.Issue.Subject != "" {
+			t.Error("Invalid issue passed to the server")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.AddIssueComment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, is, comment)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAddIssueCommentReqInternalErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.AddIssueComment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 3, entities.Comment{Text: "note"})
+	assertErr(t, err, entities.ErrRemoteServer)
+}
+
+func TestUploadReq(t *testing.T) {
+	a := entities.NewAttachment{
+		Filename: "screen shot.png",
+		Content:  []byte{1, 2, 3},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != post {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/uploads.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("filename") != a.Filename {
+			t.Errorf("Invalid filename %s", r.URL.Query().Get("filename"))
+		}
+		if r.Header.Get("Content-Type") != "application/octet-stream" {
+			t.Errorf("Invalid content type %s", r.Header.Get("Content-Type"))
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if !reflect.DeepEqual(b, a.Content) {
+			t.Errorf("Invalid content %v", b)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"upload":{"token":"7167.ed1ccdb093229ca1bd0b043618d88743"}}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	token, err := r.upload(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "7167.ed1ccdb093229ca1bd0b043618d88743" {
+		t.Errorf("Invalid token %s", token)
+	}
+}
+
+func TestCreateIssueWithAttachmentsReq(t *testing.T) {
+	testIssue := entities.NewIssue{
+		Issue: *issueJSON,
+		Attachments: []entities.NewAttachment{
+			{
+				Filename:    "screenshot.png",
+				ContentType: "image/png",
+				Description: "Login form",
+				Content:     []byte{1, 2, 3},
+			},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/uploads.json" {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"upload":{"token":"1.abc"}}`))
+			return
+		}
+		var ir issueRoot
+		unmarshal(t, r.Body, &ir)
+		expected := []upload{{
+			Token:       "1.abc",
+			Filename:    "screenshot.png",
+			ContentType: "image/png",
+			Description: "Login form",
+		}}
+		if !reflect.DeepEqual(ir.Issue.Uploads, expected) {
+			t.Errorf("Invalid uploads %+v", ir.Issue.Uploads)
+		}
+		w.WriteHeader(http.StatusCreated)
+		b, _ := json.Marshal(ir)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.createIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, testIssue, 3, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttachToIssueReq(t *testing.T) {
+	is := entities.IssueID(3)
+	var uploadsCounter int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/uploads.json" {
+			n := atomic.AddInt64(&uploadsCounter, 1)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"upload":{"token":"%d.abc"}}`, n)
+			return
+		}
+		if r.Method != put {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/"+strconv.Itoa(int(is))+".json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		var ir issueRoot
+		unmarshal(t, r.Body, &ir)
+		if len(ir.Issue.Uploads) != 2 ||
+			ir.Issue.Uploads[0].Token != "1.abc" ||
+			ir.Issue.Uploads[1].Token != "2.abc" ||
+			ir.Issue.Uploads[1].Filename != "b.txt" {
+			t.Errorf("Invalid uploads %+v", ir.Issue.Uploads)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.AttachToIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, is, []entities.NewAttachment{
+		{Filename: "a.txt", Content: []byte("a")},
+		{Filename: "b.txt", Content: []byte("b")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAttachToIssueReqUploadErr(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/uploads.json" {
+			t.Error("Issue should not be updated")
+		}
+		w.WriteHeader(422)
+		w.Write([]byte(`{"errors":["This file cannot be uploaded because it exceeds the maximum allowed file size"]}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.AttachToIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 3, []entities.NewAttachment{
+		{Filename: "a.txt", Content: []byte("a")},
+	})
+	if err == nil {
+		t.Error("Error expected")
+	}
+}
+
+func TestIssueAttachmentsReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/issues/71307.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include") != "attachments" {
+			t.Error("Missed include query param")
+		}
+		w.Write(readTestFile(t, attachmentsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	as, err := r.IssueAttachments(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]entities.Attachment{attachmentJSON}, as) {
+		t.Errorf("Unexpected result %+v", as)
+	}
+}
+
+func TestDownloadAttachmentReq(t *testing.T) {
+	content := []byte{1, 2, 3}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/attachments/4021.json":
+			w.Write(readTestFile(t, attachmentFile))
+		case "/attachments/download/4021":
+			w.Write(content)
+		default:
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	r := NewClient(testTimeout())
+	a, err := r.DownloadAttachment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 4021, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *a != attachmentJSON {
+		t.Errorf("Unexpected attachment %+v", a)
+	}
+	if !reflect.DeepEqual(buf.Bytes(), content) {
+		t.Errorf("Unexpected content %v", buf.Bytes())
+	}
+}
+
+func TestDownloadAttachmentReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.DownloadAttachment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 4021, ioutil.Discard)
+	assertErr(t, err, entities.ErrAttachmentNotFound)
+}
+
+func TestDownloadAttachmentReqTooLarge(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/attachments/4021.json":
+			w.Write([]byte(fmt.Sprintf(`{"attachment":{"id":4021,"filename":"dump.sql","filesize":%d}}`, maxAttachmentSize+1)))
+		default:
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.DownloadAttachment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 4021, ioutil.Discard)
+	assertErr(t, err, entities.ErrAttachmentTooLarge)
+}
+
+func TestDownloadAttachmentReqContentTooLarge(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/attachments/4021.json":
+			w.Write(readTestFile(t, attachmentFile))
+		case "/attachments/download/4021":
+			w.Write(make([]byte, maxAttachmentSize+1))
+		default:
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	r := NewClient(testTimeout())
+	_, err := r.DownloadAttachment(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 4021, &buf)
+	assertErr(t, err, entities.ErrAttachmentTooLarge)
+	if buf.Len() > maxAttachmentSize+1 {
+		t.Errorf("Content is not limited, %d bytes are read", buf.Len())
+	}
+}
+
+func TestIssueRelationsReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/71307/relations.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"relations":[
+			{"id":1819,"issue_id":71307,"issue_to_id":71306,"relation_type":"blocks","delay":null},
+			{"id":1820,"issue_id":71305,"issue_to_id":71307,"relation_type":"precedes","delay":3}
+		]}`))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	rs, err := r.IssueRelations(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 71307)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []entities.Relation{
+		{ID: 1819, IssueID: 71307, IssueToID: 71306, Type: "blocks"},
+		{ID: 1820, IssueID: 71305, IssueToID: 71307, Type: "precedes", Delay: 3},
+	}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Unexpected result %+v", rs)
+	}
+}
+
+func TestCreateRelationReq(t *testing.T) {
+	rel := entities.Relation{
+		IssueID:   71307,
+		IssueToID: 71306,
+		Type:      "relates",
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != post {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/issues/71307/relations.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		var rr relationRoot
+		unmarshal(t, r.Body, &rr)
+		if rr.Relation.IssueToID != int64(rel.IssueToID) ||
+			rr.Relation.RelationType != rel.Type ||
+			rr.Relation.Delay != nil {
+			t.Errorf("Invalid relation passed to the server %+v", rr.Relation)
+		}
+		rr.Relation.ID = 1821
+		rr.Relation.IssueID = int64(rel.IssueID)
+		w.WriteHeader(http.StatusCreated)
+		b, _ := json.Marshal(rr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	created, err := r.CreateRelation(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, rel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel.ID = 1821
+	if *created != rel {
+		t.Errorf("Unexpected result %+v", created)
+	}
+}
+
+func TestCreateRelationReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.CreateRelation(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, entities.Relation{IssueID: 1, IssueToID: 2, Type: "blocks"})
+	assertErr(t, err, entities.ErrIssueNotFound)
+}
+
+func TestDeleteRelationReq(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != del {
+				t.Errorf("Invalid method %s", r.Method)
+			}
+			if r.URL.Path != "/relations/1819.json" {
+				t.Errorf("Unexpected resource path %s", r.URL.Path)
+			}
+			w.WriteHeader(status)
+		}))
+
+		r := NewClient(testTimeout())
+		err := r.DeleteRelation(context.Background(), entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		}, 0, 1819)
+		if err != nil {
+			t.Errorf("Unexpected error for status %d: %v", status, err)
+		}
+		ts.Close()
+	}
+}
+
+func TestDeleteRelationReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	err := r.DeleteRelation(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 1819)
+	assertErr(t, err, entities.ErrRelationNotFound)
+}
+
+func TestSearchReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/projects/223/search.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		expected := url.Values{
+			"q":           {"rest client"},
+			"issues":      {"1"},
+			"wiki_pages":  {"1"},
+			"titles_only": {"1"},
+			"offset":      {"0"},
+			"limit":       {"2"},
+		}
+		if !reflect.DeepEqual(expected, r.URL.Query()) {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readTestFile(t, searchFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	rs, amount, err := r.Search(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.SearchQuery{
+		Text:       "rest client",
+		ProjectID:  223,
+		Types:      []string{entities.SearchIssues, entities.SearchWikiPages},
+		TitlesOnly: true,
+	}, entities.Pagination{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 12 {
+		t.Errorf("Unexpected amount %d", amount)
+	}
+	expected := []entities.SearchResult{
+		{
+			ID:      71307,
+			Type:    "issue",
+			Title:   "Task #71307 (InProgress): Develop Redmine Tracker Adapter MS",
+			URL:     "https://redmine.example.com/issues/71307",
+			Snippet: "Adapter should implement rest client for Redmine",
+			Updated: 1465553608,
+		},
+		{
+			ID:      57,
+			Type:    "wiki-page",
+			Title:   "Wiki: Rest client",
+			URL:     "https://redmine.example.com/projects/timeguard/wiki/Rest_client",
+			Snippet: "Rest client configuration",
+			Updated: 1465463452,
+		},
+	}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Unexpected result %+v", rs)
+	}
+}
+
+func TestSearchReqAllProjects(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != searchResource {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("q") != "adapter" || q.Get("limit") != "100" || q.Get("issues") != "" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readTestFile(t, searchFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	rs, _, err := r.Search(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.SearchQuery{Text: "adapter"}, entities.Pagination{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Errorf("Unexpected result %+v", rs)
+	}
+}
+
+func TestSearchReqInvalidQuery(t *testing.T) {
+	r := NewClient(testTimeout())
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         "http://localhost",
+		Type:        redmineType,
+	}
+	_, _, err := r.Search(context.Background(), tr, entities.SearchQuery{Text: "  "}, entities.Pagination{})
+	assertErr(t, err, entities.ErrSearchQuery)
+	_, _, err = r.Search(context.Background(), tr, entities.SearchQuery{Text: "adapter", Types: []string{"boards"}}, entities.Pagination{})
+	assertErr(t, err, entities.ErrSearchQuery)
+}
+
+func TestSearchReqProjectNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Search(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.SearchQuery{Text: "adapter", ProjectID: 1}, entities.Pagination{})
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestWikiIndexReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/projects/223/wiki/index.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, wikiPagesFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	ps, err := r.WikiIndex(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []entities.WikiPageInfo{
+		{
+			Title:   "Wiki",
+			Version: 4,
+			Created: 1465463452,
+			Updated: 1465553608,
+		},
+		{
+			Title:   "Rest_client",
+			Parent:  "Wiki",
+			Version: 2,
+			Created: 1465463452,
+			Updated: 1465463452,
+		},
+	}
+	if !reflect.DeepEqual(expected, ps) {
+		t.Errorf("Unexpected result %+v", ps)
+	}
+}
+
+func TestWikiIndexReqProjectNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.WikiIndex(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1)
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestWikiPageReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.EscapedPath() != "/projects/223/wiki/Rest_client.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.EscapedPath())
+		}
+		w.Write(readTestFile(t, wikiPageFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	p, err := r.WikiPage(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, "Rest_client", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &entities.WikiPage{
+		WikiPageInfo: entities.WikiPageInfo{
+			Title:   "Rest_client",
+			Parent:  "Wiki",
+			Version: 2,
+			Created: 1465463452,
+			Updated: 1465463452,
+		},
+		Text: "h1. Rest client\r\n\r\nRest client configuration",
+		Author: entities.User{
+			ID:   342,
+			Name: "Yaroslav Rogov",
+		},
+		Comments: "Configuration notes",
+	}
+	if !reflect.DeepEqual(expected, p) {
+		t.Errorf("Unexpected result %+v", p)
+	}
+}
+
+func TestWikiPageReqVersion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/projects/223/wiki/Rest%20client/1.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.EscapedPath())
+		}
+		w.Write(readTestFile(t, wikiPageFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.WikiPage(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, "Rest client", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWikiPageReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.WikiPage(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, "Missing", 0)
+	assertErr(t, err, entities.ErrWikiPageNotFound)
+}
+
+func TestSavedQueriesReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/queries.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, queriesFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	tr := entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}
+	qs, err := r.SavedQueries(context.Background(), tr, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(savedQueriesJSON, qs) {
+		t.Errorf("Unexpected result %+v", qs)
+	}
+	qs, err = r.SavedQueries(context.Background(), tr, 223)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(savedQueriesJSON[:2], qs) {
+		t.Errorf("Unexpected project queries %+v", qs)
+	}
+}
+
+func TestSavedQueriesReqPages(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		qr := queriesRoot{
+			TotalCount: 150,
+			Limit:      100,
+		}
+		for i := offset; i < 150 && i < offset+100; i++ {
+			qr.Queries = append(qr.Queries, query{ID: int64(i + 1)})
+		}
+		b, _ := json.Marshal(qr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	qs, err := r.SavedQueries(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&requests) != 2 {
+		t.Errorf("Unexpected amount of requests %d", requests)
+	}
+	if len(qs) != 150 || qs[149].ID != 150 {
+		t.Errorf("Unexpected queries %d", len(qs))
+	}
+}
+
+func TestSavedQueriesReqForbidden(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.SavedQueries(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0)
+	assertErr(t, err, entities.ErrForbidden)
+}
+
+func TestProjectVersionsReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/projects/223/versions.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, versionsFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	vs, err := r.ProjectVersions(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(versionsJSON, vs) {
+		t.Errorf("Unexpected result %+v", vs)
+	}
+}
+
+func TestProjectVersionsReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.ProjectVersions(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223)
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestCreateVersionReq(t *testing.T) {
+	v := entities.Version{
+		Name:    "Release 2",
+		Status:  "open",
+		Sharing: "descendants",
+		DueDate: 1483142400,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != post {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/projects/223/versions.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		var vr versionRoot
+		unmarshal(t, r.Body, &vr)
+		if vr.Version.Name != v.Name ||
+			vr.Version.Status != v.Status ||
+			vr.Version.Sharing != v.Sharing ||
+			vr.Version.DueDate != "2016-12-31" {
+			t.Errorf("Invalid version passed to the server %+v", vr.Version)
+		}
+		vr.Version.ID = 930
+		vr.Version.Project = &idName{ID: 223, Name: "Timeguard"}
+		w.WriteHeader(http.StatusCreated)
+		b, _ := json.Marshal(vr)
+		w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	created, err := r.CreateVersion(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 223, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.ID = 930
+	v.ProjectID = 223
+	if *created != v {
+		t.Errorf("Unexpected result %+v", created)
+	}
+}
+
+func TestUpdateIssueVersion(t *testing.T) {
+	tests := map[string]struct {
+		version  *entities.TypeID
+		passed   bool
+		expected interface{}
+	}{
+		"Keep":  {nil, false, nil},
+		"Set":   {&entities.TypeID{ID: 925}, true, 925.0},
+		"Clear": {&entities.TypeID{}, true, nil},
+	}
+	for label, test := range tests {
+		b, err := json.Marshal(toIssueParams(entities.Issue{Version: test.version}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatal(err)
+		}
+		v, ok := body["issue"]["fixed_version_id"]
+		if ok != test.passed || v != test.expected {
+			t.Errorf("Test %s unexpected body %s", label, b)
+		}
+	}
+}
+
+func TestUpdateIssueAssignee(t *testing.T) {
+	tests := map[string]struct {
+		assignee *entities.TypeID
+		passed   bool
+		expected interface{}
+	}{
+		"Keep":   {nil, false, nil},
+		"Set":    {&entities.TypeID{ID: 1131}, true, 1131.0},
+		"Nobody": {&entities.TypeID{}, true, nil},
+	}
+	for label, test := range tests {
+		b, err := json.Marshal(toIssueParams(entities.Issue{Assignee: test.assignee}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatal(err)
+		}
+		v, ok := body["issue"]["assigned_to_id"]
+		if ok != test.passed || v != test.expected {
+			t.Errorf("Test %s unexpected body %s", label, b)
+		}
+	}
+}
+
+func TestUpdateIssuePriorityAndCategory(t *testing.T) {
+	tests := map[string]struct {
+		issue    entities.Issue
+		priority interface{}
+		category interface{}
+		passed   bool
+	}{
+		"Keep":  {entities.Issue{}, nil, nil, false},
+		"Set":   {entities.Issue{Priority: entities.TypeID{ID: 5}, Category: &entities.TypeID{ID: 12}}, 5.0, 12.0, true},
+		"Clear": {entities.Issue{Category: &entities.TypeID{}}, nil, nil, true},
+	}
+	for label, test := range tests {
+		b, err := json.Marshal(toIssueParams(test.issue))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatal(err)
+		}
+		c, ok := body["issue"]["category_id"]
+		if ok != test.passed || c != test.category || body["issue"]["priority_id"] != test.priority {
+			t.Errorf("Test %s unexpected body %s", label, b)
+		}
+	}
+}
+
+func TestIssueParams(t *testing.T) {
+	tests := map[string]struct {
+		issue    entities.Issue
+		expected string
+	}{
+		"Non zero fields": {
+			issue: *issueJSON,
+			expected: `{"issue":{
+				"id":71307,
+				"subject":"Develop Redmine Tracker Adapter MS",
+				"description":"https://docs.google.com/document",
+				"tracker_id":19,
+				"status_id":10,
+				"priority_id":4,
+				"assigned_to_id":1131,
+				"category_id":12,
+				"fixed_version_id":925,
+				"parent_issue_id":71300,
+				"start_date":"2016-06-09",
+				"due_date":"2016-06-14",
+				"estimated_hours":24,
+				"done_ratio":10,
+				"custom_fields":[{"id":79,"value":"Not Approved"}]
+			}}`,
+		},
+		"Listed fields": {
+			issue: entities.Issue{
+				ID:      71307,
+				Title:   "Develop Redmine Tracker Adapter MS",
+				Private: true,
+				Fields: []entities.IssueField{
+					entities.IssueFieldDescription,
+					entities.IssueFieldAssignee,
+					entities.IssueFieldCategory,
+					entities.IssueFieldVersion,
+					entities.IssueFieldParent,
+					entities.IssueFieldStartDate,
+					entities.IssueFieldDueDate,
+					entities.IssueFieldEstimate,
+					entities.IssueFieldDone,
+					entities.IssueFieldPrivate,
+					entities.IssueFieldCustomFields,
+				},
+			},
+			expected: `{"issue":{
+				"id":71307,
+				"description":"",
+				"assigned_to_id":null,
+				"category_id":null,
+				"fixed_version_id":null,
+				"parent_issue_id":null,
+				"start_date":null,
+				"due_date":null,
+				"estimated_hours":null,
+				"done_ratio":0,
+				"is_private":true,
+				"custom_fields":[]
+			}}`,
+		},
+		"Listed zero required fields": {
+			issue: entities.Issue{
+				ID:   71307,
+				Done: 50,
+				Fields: []entities.IssueField{
+					entities.IssueFieldType,
+					entities.IssueFieldStatus,
+					entities.IssueFieldPriority,
+					entities.IssueFieldDone,
+				},
+			},
+			expected: `{"issue":{
+				"id":71307,
+				"done_ratio":50
+			}}`,
+		},
+	}
+	for label, test := range tests {
+		b, err := json.Marshal(toIssueParams(test.issue))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var actual, expected interface{}
+		if err := json.Unmarshal(b, &actual); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Test %s unexpected body %s", label, b)
+		}
+	}
+}
+
+func TestIssueParamsRoundTrip(t *testing.T) {
+	i := entities.Issue{
+		Title:       "Develop Redmine Tracker Adapter MS",
+		Description: "https://docs.google.com/document",
+		ParentID:    71300,
+		StartDate:   1465430400,
+		DueDate:     1465862400,
+		Estimate:    86400,
+		Done:        10,
+		Private:     true,
+		CustomFields: []entities.CustomField{
+			{ID: 79, Values: []string{"Not Approved"}},
+		},
+	}
+	b, err := json.Marshal(toIssueParams(i))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ir issueRoot
+	if err := json.Unmarshal(b, &ir); err != nil {
+		t.Fatal(err)
+	}
+	var pr struct {
+		Issue struct {
+			ParentIssueID int64 `json:"parent_issue_id"`
+		} `json:"issue"`
+	}
+	if err := json.Unmarshal(b, &pr); err != nil {
+		t.Fatal(err)
+	}
+	ir.Issue.Parent = &idName{ID: pr.Issue.ParentIssueID}
+	actual := toIssue(ir.Issue, entities.Tracker{})
+	actual.URL = ""
+	if !reflect.DeepEqual(i, actual) {
+		t.Errorf("Unexpected issue %+v", actual)
+	}
+}
+
+func TestIssuePrioritiesReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/enumerations/issue_priorities.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, prioritiesFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	ps, err := r.IssuePriorities(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []entities.IssuePriority{
+		{ID: 3, Name: "Low"},
+		{ID: 4, Name: "Normal", IsDefault: true},
+		{ID: 5, Name: "High"},
+		{ID: 6, Name: "Urgent"},
+	}
+	if !reflect.DeepEqual(expected, ps) {
+		t.Errorf("Unexpected result %+v", ps)
+	}
+}
+
+func TestIssueCategoriesReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/projects/223/issue_categories.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		w.Write(readTestFile(t, categoriesFile))
 	}))
 	defer ts.Close()
 
-	tr := entities.Tracker{
+	r := NewClient(testTimeout())
+	cs, err := r.IssueCategories(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
-	}
-
-	r := NewClient(testTimeout())
-	_, err := r.Project(context.Background(), tr, 1)
-	if err == nil {
+	}, 223)
+	if err != nil {
 		t.Fatal(err)
 	}
+	expected := []entities.IssueCategory{
+		{ID: 12, ProjectID: 223, Name: "Backend"},
+		{ID: 13, ProjectID: 223, Name: "Frontend"},
+	}
+	if !reflect.DeepEqual(expected, cs) {
+		t.Errorf("Unexpected result %+v", cs)
+	}
 }
 
-func TestProjectsErr(t *testing.T) {
+func TestIssueCategoriesReqNotFound(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer ts.Close()
 
-	page := entities.Pagination{
-		Offset: 41,
-		Limit:  88,
-	}
-
-	tr := entities.Tracker{
+	r := NewClient(testTimeout())
+	_, err := r.IssueCategories(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
-	}
+	}, 1)
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestCreateIssueReqAssignee(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == currentUserResourse {
+			w.Write(readTestFile(t, userFile))
+			return
+		}
+		var body map[string]map[string]interface{}
+		unmarshal(t, r.Body, &body)
+		if v, ok := body["issue"]["assigned_to_id"]; !ok || v != nil {
+			t.Errorf("Unexpected assignee %v", v)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"issue":{"id":555,"subject":"Unassigned"}}`))
+	}))
+	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	_, _, err := r.Projects(context.Background(), tr, page)
-	if err == nil {
+	issue, err := r.CreateIssue(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, entities.NewIssue{
+		Issue: entities.Issue{
+			Title:    "Unassigned",
+			Assignee: &entities.TypeID{},
+		},
+	}, 3)
+	if err != nil {
 		t.Fatal(err)
 	}
+	if issue.ID != 555 || issue.Assignee != nil {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
 }
 
-func TestProject(t *testing.T) {
+func TestProjectMembersReq(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/project") {
-			w.Write(readTestFile(t, projectFile))
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
 		}
-		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
-			w.Write(readTestFile(t, timeentriesFile))
+		if r.URL.Path != "/projects/223/memberships.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
 		}
+		if r.URL.Query().Get("offset") != "0" || r.URL.Query().Get("limit") != "100" {
+			t.Errorf("Invalid pagination %s", r.URL.RawQuery)
+		}
+		w.Write(readTestFile(t, membershipsFile))
 	}))
 	defer ts.Close()
 
-	tr := entities.Tracker{
+	r := NewClient(testTimeout())
+	ms, amount, err := r.ProjectMembers(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
+	}, 223, entities.Pagination{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 2 {
+		t.Errorf("Unexpected amount %d", amount)
 	}
+	expected := []entities.ProjectMember{
+		{
+			ID:   2110,
+			User: entities.TypeID{ID: 1131, Name: "Prylutskyi, Anatolii"},
+			Roles: []entities.TypeID{
+				{ID: 3, Name: "Manager"},
+				{ID: 4, Name: "Developer"},
+			},
+		},
+		{
+			ID:      2114,
+			User:    entities.TypeID{ID: 77, Name: "QA team"},
+			IsGroup: true,
+			Roles: []entities.TypeID{
+				{ID: 5, Name: "Reporter"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, ms) {
+		t.Errorf("Unexpected result %+v", ms)
+	}
+}
+
+func TestProjectMembersReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	pr, err := r.Project(context.Background(), tr, 1)
-	if pr == nil {
-		t.Error("Should return project")
-	}
+	_, _, err := r.ProjectMembers(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1, entities.Pagination{})
+	assertErr(t, err, entities.ErrProjectNotFound)
+}
+
+func TestReportsReq(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
+		}
+		if r.URL.Path != "/time_entries.json" {
+			t.Errorf("Unexpected resource path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("user_id") != "me" ||
+			q.Get("from") != "2016-06-13" ||
+			q.Get("to") != "2016-06-14" ||
+			q.Get("offset") != "0" ||
+			q.Get("limit") != "100" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readTestFile(t, timeentriesFile))
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	reports, amount, err := r.Reports(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465776000, 1465862400, entities.Pagination{})
 	if err != nil {
 		t.Fatal(err)
 	}
+	if amount != 1 {
+		t.Errorf("Unexpected amount %d", amount)
+	}
+	report := entities.Report{
+		ID:         219538,
+		ProjectID:  223,
+		IssueID:    71307,
+		ActivityID: 9,
+		Comments:   "Developing rest client",
+		Duration:   8 * 60 * 60,
+		Started:    1465862400,
+		CustomFields: []entities.CustomField{
+			{
+				ID:     29,
+				Name:   "Type of the hours",
+				Values: []string{"Default for the project"},
+			},
+		},
+	}
+	if len(reports) != 2 || !reflect.DeepEqual(report, reports[0]) {
+		t.Errorf("Unexpected result %+v", reports)
+	}
 }
 
-func TestProjectsActivityErr(t *testing.T) {
+func TestReportsReqInternalErr(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/projects") {
-			w.Write(readTestFile(t, projectsFile))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, _, err := r.Reports(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465776000, 1465862400, entities.Pagination{})
+	assertErr(t, err, entities.ErrRemoteServer)
+}
+
+func TestReportsSummaryReq(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"time_entries":[
+			{"id":1,"project":{"id":223,"name":"TimeGuard"},"issue":{"id":71307},"activity":{"id":9,"name":"Development"},"hours":2.5,"spent_on":"2016-06-13"},
+			{"id":2,"project":{"id":223,"name":"TimeGuard"},"issue":{"id":71306},"activity":{"id":10,"name":"Design"},"hours":1.0,"spent_on":"2016-06-13"}
+		],"total_count":3,"offset":0,"limit":2}`,
+		"2": `{"time_entries":[
+			{"id":3,"project":{"id":170,"name":"Internal"},"activity":{"id":9,"name":"Development"},"hours":4.0,"spent_on":"2016-06-14"}
+		],"total_count":3,"offset":2,"limit":2}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != get {
+			t.Errorf("Invalid method %s", r.Method)
 		}
-		if strings.HasPrefix(r.URL.Path, "/enumerations/time_entry_activities.json") {
-			w.WriteHeader(http.StatusInternalServerError)
+		q := r.URL.Query()
+		if r.URL.Path != "/time_entries.json" || q.Get("from") != "2016-06-13" || q.Get("to") != "2016-06-14" {
+			t.Errorf("Unexpected resource %s", r.URL)
 		}
+		page, ok := pages[q.Get("offset")]
+		if !ok {
+			t.Errorf("Unexpected offset %s", q.Get("offset"))
+		}
+		w.Write([]byte(page))
 	}))
 	defer ts.Close()
 
-	page := entities.Pagination{
-		Offset: 41,
-		Limit:  88,
+	r := NewClient(testTimeout())
+	s, err := r.ReportsSummary(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 1465776000, 1465862400)
+	if err != nil {
+		t.Fatal(err)
 	}
+	expected := entities.ReportsSummary{
+		Total: 7.5 * 60 * 60,
+		ByDay: []entities.ReportsTotal{
+			{ID: 1465776000, Name: "2016-06-13", Duration: 3.5 * 60 * 60},
+			{ID: 1465862400, Name: "2016-06-14", Duration: 4 * 60 * 60},
+		},
+		ByIssue: []entities.ReportsTotal{
+			{ID: 0, Duration: 4 * 60 * 60},
+			{ID: 71306, Duration: 1 * 60 * 60},
+			{ID: 71307, Duration: 2.5 * 60 * 60},
+		},
+		ByProject: []entities.ReportsTotal{
+			{ID: 170, Name: "Internal", Duration: 4 * 60 * 60},
+			{ID: 223, Name: "TimeGuard", Duration: 3.5 * 60 * 60},
+		},
+		ByActivity: []entities.ReportsTotal{
+			{ID: 9, Name: "Development", Duration: 6.5 * 60 * 60},
+			{ID: 10, Name: "Design", Duration: 1 * 60 * 60},
+		},
+	}
+	if !reflect.DeepEqual(expected, *s) {
+		t.Errorf("Unexpected result %+v", s)
+	}
+}
 
-	tr := entities.Tracker{
+func TestReportsSummaryReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	r := NewClient(testTimeout())
+	_, err := r.ReportsSummary(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
+	}, 1465776000, 1465862400)
+	assertErr(t, err, entities.ErrTrackerURL)
+}
+
+func TestUpdateReportReq(t *testing.T) {
+	report := entities.Report{
+		ID:         219538,
+		Duration:   5400,
+		IssueID:    71307,
+		Started:    1465862400,
+		ActivityID: 9,
+		Comments:   "Developing rest client",
+	}
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != put {
+				t.Errorf("Invalid method %s", r.Method)
+			}
+			if r.URL.Path != "/time_entries/219538.json" {
+				t.Errorf("Unexpected resource path %s", r.URL.Path)
+			}
+			var ter timeEntryRoot
+			unmarshal(t, r.Body, &ter)
+			if ter.TimeEntry.IssueID != int64(report.IssueID) ||
+				ter.TimeEntry.Hours != 1.5 ||
+				ter.TimeEntry.SpentOn != "2016-06-14" ||
+				ter.TimeEntry.Comments != report.Comments {
+				t.Errorf("Invalid time entry passed to the server %+v", ter.TimeEntry)
+			}
+			w.WriteHeader(status)
+		}))
+
+		r := NewClient(testTimeout())
+		err := r.UpdateReport(context.Background(), entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		}, 0, report)
+		if err != nil {
+			t.Errorf("Unexpected error for status %d: %v", status, err)
+		}
+		ts.Close()
 	}
+}
 
-	r := NewClient(testTimeout())
-	_, _, err := r.Projects(context.Background(), tr, page)
-	if err == nil {
-		t.Fatal(err)
+func TestUpdateReportReqFields(t *testing.T) {
+	tests := []struct {
+		report entities.Report
+		params string
+	}{
+		{
+			report: entities.Report{ID: 219538, Duration: 3600, Fields: []entities.ReportField{entities.ReportFieldDuration}},
+			params: `{"hours":1}`,
+		},
+		{
+			report: entities.Report{ID: 219538, Duration: 3600},
+			params: `{"hours":1}`,
+		},
+		{
+			report: entities.Report{ID: 219538, Fields: []entities.ReportField{
+				entities.ReportFieldIssue,
+				entities.ReportFieldComments,
+				entities.ReportFieldActivity,
+				entities.ReportFieldDuration,
+			}},
+			params: `{"comments":"","issue_id":null}`,
+		},
+	}
+	for _, test := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var ter struct {
+				TimeEntry json.RawMessage `json:"time_entry"`
+			}
+			unmarshal(t, r.Body, &ter)
+			if string(ter.TimeEntry) != test.params {
+				t.Errorf("Unexpected time entry %s for fields %v, expected %s", ter.TimeEntry, test.report.Fields, test.params)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		r := NewClient(testTimeout())
+		err := r.UpdateReport(context.Background(), entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		}, 0, test.report)
+		if err != nil {
+			t.Error(err)
+		}
+		ts.Close()
 	}
 }
 
-func TestProjectErr(t *testing.T) {
+func TestUpdateReportReqNotFound(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer ts.Close()
 
-	tr := entities.Tracker{
+	r := NewClient(testTimeout())
+	err := r.UpdateReport(context.Background(), entities.Tracker{
 		Credentials: testCreds,
 		URL:         ts.URL,
 		Type:        redmineType,
+	}, 0, entities.Report{ID: 1, Duration: 3600})
+	assertErr(t, err, entities.ErrReportNotFound)
+}
+
+func TestDeleteReportReq(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != del {
+				t.Errorf("Invalid method %s", r.Method)
+			}
+			if r.URL.Path != "/time_entries/219538.json" {
+				t.Errorf("Unexpected resource path %s", r.URL.Path)
+			}
+			w.WriteHeader(status)
+		}))
+
+		r := NewClient(testTimeout())
+		err := r.DeleteReport(context.Background(), entities.Tracker{
+			Credentials: testCreds,
+			URL:         ts.URL,
+			Type:        redmineType,
+		}, 0, 219538)
+		if err != nil {
+			t.Errorf("Unexpected error for status %d: %v", status, err)
+		}
+		ts.Close()
 	}
+}
+
+func TestDeleteReportReqNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
 
 	r := NewClient(testTimeout())
-	_, err := r.Project(context.Background(), tr, 1)
-	if err == nil {
+	err := r.DeleteReport(context.Background(), entities.Tracker{
+		Credentials: testCreds,
+		URL:         ts.URL,
+		Type:        redmineType,
+	}, 0, 219538)
+	assertErr(t, err, entities.ErrReportNotFound)
+}
+
+func TestLoadPagesLimit(t *testing.T) {
+	var running, maxRunning, loaded int64
+	err := loadPages(context.Background(), 20, func(ctx context.Context, page int) error {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			m := atomic.LoadInt64(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt64(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt64(&running, -1)
+		atomic.AddInt64(&loaded, 1)
+		return nil
+	})
+	if err != nil {
 		t.Fatal(err)
 	}
+	if loaded != 19 {
+		t.Errorf("Unexpected amount of loaded pages %d", loaded)
+	}
+	if maxRunning > parallelPagesLimit {
+		t.Errorf("Too many pages loaded at once %d", maxRunning)
+	}
+}
+
+func TestLoadPagesCancel(t *testing.T) {
+	loadErr := errors.New("page error")
+	var canceled int64
+	err := loadPages(context.Background(), 20, func(ctx context.Context, page int) error {
+		if page == 1 {
+			return loadErr
+		}
+		select {
+		case <-ctx.Done():
+			atomic.AddInt64(&canceled, 1)
+			return ctx.Err()
+		case <-time.After(testTimeout()):
+			return nil
+		}
+	})
+	if err != loadErr {
+		t.Errorf("Unexpected error %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if atomic.LoadInt64(&canceled) == 0 {
+		t.Error("Rest of pages are not canceled")
+	}
 }
 
 func TestDateToSeconds(t *testing.T) {
@@ -1442,44 +4473,182 @@ var userJSON = entities.User{
 var issueJSON = &entities.Issue{
 	ID:          71307,
 	DueDate:     1465862400,
+	StartDate:   1465430400,
 	ProjectID:   223,
 	Description: "https://docs.google.com/document",
 	Type: entities.TypeID{
 		ID:   19,
 		Name: "Task",
 	},
+	Status: entities.TypeID{
+		ID:   10,
+		Name: "InProgress",
+	},
+	Version: &entities.TypeID{
+		ID:   925,
+		Name: "Stand alone timeguard",
+	},
+	Assignee: &entities.TypeID{
+		ID:   1131,
+		Name: "Prylutskyi, Anatolii",
+	},
+	Priority: entities.TypeID{
+		ID:   4,
+		Name: "Normal",
+	},
+	Category: &entities.TypeID{
+		ID:   12,
+		Name: "Backend",
+	},
 	Title:    "Develop Redmine Tracker Adapter MS",
+	Created:  1465463452,
+	Updated:  1465553608,
 	Estimate: 86400,
 	Done:     10,
 	Spent:    10 * 60 * 60,
+	CustomFields: []entities.CustomField{
+		{
+			ID:     79,
+			Name:   "Assessment",
+			Values: []string{"Not Approved"},
+		},
+	},
+	Relations: []entities.Relation{
+		{
+			ID:        1819,
+			IssueID:   71307,
+			IssueToID: 71306,
+			Type:      "blocks",
+		},
+	},
+	ParentID: 71300,
+	Children: []entities.IssueChild{
+		{
+			ID: 71310,
+			Type: entities.TypeID{
+				ID:   19,
+				Name: "Task",
+			},
+			Title: "Implement projects API",
+			Children: []entities.IssueChild{
+				{
+					ID: 71312,
+					Type: entities.TypeID{
+						ID:   1,
+						Name: "Bug",
+					},
+					Title: "Fix projects pagination",
+				},
+			},
+		},
+	},
+	TotalSpent:    14.5 * 60 * 60,
+	TotalEstimate: 40 * 60 * 60,
 }
 
 var issuesJSON = []entities.Issue{
 	{
 		ID:        71307,
 		DueDate:   1465862400,
+		StartDate: 1465430400,
 		ProjectID: 223,
 		Type: entities.TypeID{
 			ID:   19,
 			Name: "Task",
 		},
+		Status: entities.TypeID{
+			ID:   10,
+			Name: "InProgress",
+		},
+		Version: &entities.TypeID{
+			ID:   925,
+			Name: "Stand alone timeguard",
+		},
+		Assignee: &entities.TypeID{
+			ID:   1131,
+			Name: "Prylutskyi, Anatolii",
+		},
+		Priority: entities.TypeID{
+			ID:   4,
+			Name: "Normal",
+		},
 		Title:       "Develop Redmine Tracker Adapter MS",
 		Description: "https://docs.google.com/document/d",
 		Estimate:    86400,
 		Done:        20,
+		Created:     1465463452,
+		Updated:     1465553608,
+		CustomFields: []entities.CustomField{
+			{
+				ID:     79,
+				Name:   "Assessment",
+				Values: []string{"Not Approved"},
+			},
+		},
 	},
 	{
 		ID:        71306,
 		DueDate:   1465430400,
+		StartDate: 1465430400,
 		ProjectID: 223,
 		Type: entities.TypeID{
 			ID:   19,
 			Name: "Task",
 		},
+		Status: entities.TypeID{
+			ID:   1,
+			Name: "New",
+		},
+		Version: &entities.TypeID{
+			ID:   925,
+			Name: "Stand alone timeguard",
+		},
+		Assignee: &entities.TypeID{
+			ID:   1131,
+			Name: "Prylutskyi, Anatolii",
+		},
+		Priority: entities.TypeID{
+			ID:   4,
+			Name: "Normal",
+		},
 		Title:       "Review current architectural document and confirm that all technical side is correct and can be developed ",
 		Description: "Review current architectural document and confirm that all",
 		Estimate:    21600,
 		Done:        55,
+		Created:     1465463271,
+		Updated:     1465463271,
+		CustomFields: []entities.CustomField{
+			{
+				ID:     79,
+				Name:   "Assessment",
+				Values: []string{"Not Approved"},
+			},
+		},
+	},
+}
+
+var savedQueriesJSON = []entities.SavedQuery{
+	{ID: 12, Name: "This sprint", Public: true, ProjectID: 223},
+	{ID: 15, Name: "Needs estimate", Public: true},
+	{ID: 18, Name: "My internal tasks", ProjectID: 170},
+}
+
+var versionsJSON = []entities.Version{
+	{
+		ID:          925,
+		ProjectID:   223,
+		Name:        "Stand alone timeguard",
+		Description: "First standalone release",
+		Status:      "open",
+		Sharing:     "none",
+		DueDate:     1483142400,
+	},
+	{
+		ID:        870,
+		ProjectID: 170,
+		Name:      "Shared backlog",
+		Status:    "locked",
+		Sharing:   "system",
 	},
 }
 
@@ -1494,41 +4663,125 @@ var projectJSON = entities.Project{
 var projectsJSON = []entities.Project{
 	{
 		ID:            170,
+		Identifier:    "internal",
 		Title:         "Internal",
 		Description:   "Project170",
+		Status:        entities.ProjectStatusActive,
+		Created:       1330704478,
+		Updated:       1432198699,
 		IssueTypes:    issueTypesJSON,
 		ActivityTypes: []entities.TypeID{},
 	},
 	{
 		ID:            577,
+		ParentID:      170,
+		Identifier:    "bench",
 		Title:         "Bench",
 		Description:   "Project570",
+		Status:        entities.ProjectStatusActive,
+		Created:       1385198595,
+		Updated:       1385198595,
 		IssueTypes:    issueTypesJSON,
 		ActivityTypes: []entities.TypeID{},
 	},
 	{
 		ID:            379,
+		Identifier:    "education",
 		Title:         "Education",
 		Description:   "Project370",
+		Status:        entities.ProjectStatusClosed,
+		Created:       1361981925,
+		Updated:       1368692064,
 		IssueTypes:    issueTypesJSON,
 		ActivityTypes: []entities.TypeID{},
 	},
 	{
 		ID:            987,
+		Identifier:    "s-match",
 		Title:         "S-match",
 		Description:   "",
+		Status:        entities.ProjectStatusActive,
+		Created:       1445588438,
+		Updated:       1458911895,
 		IssueTypes:    issueTypesJSON,
 		ActivityTypes: []entities.TypeID{},
 	},
 	{
 		ID:            223,
+		ParentID:      170,
+		Identifier:    "timeguard",
 		Title:         "TimeGuard",
 		Description:   "TimeGuard",
+		Status:        entities.ProjectStatusActive,
+		Modules:       []string{"issue_tracking", "time_tracking", "wiki"},
+		Created:       1341313315,
+		Updated:       1357919316,
 		IssueTypes:    issueTypesJSON,
 		ActivityTypes: []entities.TypeID{},
 	},
 }
 
+var issueStatusesJSON = []entities.IssueStatus{
+	{ID: 1, Name: "New"},
+	{ID: 10, Name: "InProgress"},
+	{ID: 3, Name: "Resolved"},
+	{ID: 5, Name: "Closed", IsClosed: true},
+}
+
+var issueCommentsJSON = []entities.Comment{
+	{
+		ID: 301,
+		Author: entities.User{
+			ID:   1,
+			Name: "Kuharenko, Maks",
+		},
+		Text:    "Please check the architectural document first",
+		Created: 1465466400,
+	},
+	{
+		ID: 303,
+		Author: entities.User{
+			ID:   1131,
+			Name: "Prylutskyi, Anatolii",
+		},
+		Text:    "Rest client is ready",
+		Private: true,
+		Created: 1465553608,
+	},
+}
+
+var attachmentJSON = entities.Attachment{
+	ID:          4021,
+	Filename:    "screenshot.png",
+	Size:        3,
+	ContentType: "image/png",
+	Description: "Login form",
+	URL:         "https://redmine.qarea.org/attachments/download/4021/screenshot.png",
+	Author: entities.User{
+		ID:   1131,
+		Name: "Prylutskyi, Anatolii",
+	},
+	Created: 1465553608,
+}
+
+var projectCustomFieldsJSON = []entities.CustomFieldDefinition{
+	{
+		ID:             79,
+		Name:           "Assessment",
+		Format:         "list",
+		Required:       true,
+		PossibleValues: []string{"Not Approved", "Approved"},
+		DefaultValue:   "Not Approved",
+	},
+	{
+		ID:             81,
+		Name:           "Components",
+		Format:         "list",
+		Multiple:       true,
+		PossibleValues: []string{"API", "UI"},
+	},
+}
+
 var issueTypesJSON = []entities.TypeID{
 	{1, "Bug"},
 	{2, "Feature"},
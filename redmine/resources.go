@@ -2,35 +2,258 @@ package redmine
 
 import (
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/qarea/redminems/entities"
 )
 
 const (
-	projectsResource      = "/projects.json?include=trackers"
-	currentUserResourse   = "/users/current.json"
-	reportsResource       = "/time_entries.json"
-	timeEntriesActivities = "/enumerations/time_entry_activities.json"
+	currentUserResourse     = "/users/current.json"
+	reportsResource         = "/time_entries.json"
+	timeEntriesActivities   = "/enumerations/time_entry_activities.json"
+	issueStatusesResource   = "/issue_statuses.json"
+	issuePrioritiesResource = "/enumerations/issue_priorities.json"
+	customFieldsResource    = "/custom_fields.json"
+	searchResource          = "/search.json"
+	issuesResource          = "/issues.json"
+)
+
+const (
+	uploadsResourceTemplate            = "/uploads.json?filename=%s"
+	attachmentResourceTemplate         = "/attachments/%d.json"
+	attachmentDownloadResourceTemplate = "/attachments/download/%d"
+	issueRelationsResourceTemplate     = "/issues/%d/relations.json"
+	relationResourceTemplate           = "/relations/%d.json"
+	projectVersionsResourceTemplate    = "/projects/%d/versions.json"
+	projectSearchResourceTemplate      = "/projects/%d/search.json"
+	projectMembershipsTemplate         = "/projects/%d/memberships.json?offset=%d&limit=%d"
+	issueCategoriesTemplate            = "/projects/%d/issue_categories.json"
+	wikiIndexResourceTemplate          = "/projects/%d/wiki/index.json"
+	wikiPageResourceTemplate           = "/projects/%d/wiki/%s.json"
+	wikiPageVersionResourceTemplate    = "/projects/%d/wiki/%s/%d.json"
+	reportResourceTemplate             = "/time_entries/%d.json"
+	queriesResourceTemplate            = "/queries.json?offset=%d&limit=%d"
+	reportsRangeResourceTemplate       = "/time_entries.json?user_id=me&from=%s&to=%s&offset=%d&limit=%d"
 )
 
 const (
+	//timeEntriesPageLimit is maximum page size allowed by redmine
+	timeEntriesPageLimit = 100
+	//maxTimeEntriesPages is safety cap for amount of time entries pages loaded by single query
+	maxTimeEntriesPages = 50
+	//projectsPageLimit is maximum page size allowed by redmine
+	projectsPageLimit = 100
+	//maxProjectsPages is safety cap for amount of projects pages loaded by single query
+	maxProjectsPages = 50
+	//membershipsPageLimit is maximum page size allowed by redmine
+	membershipsPageLimit = 100
+	//queriesPageLimit is maximum page size allowed by redmine
+	queriesPageLimit = 100
+	//issueChangesPageLimit is maximum page size allowed by redmine
+	issueChangesPageLimit = 100
+	//parallelPagesLimit is maximum amount of pages loaded from tracker at once
+	parallelPagesLimit = 4
+	//maxAttachmentSize is maximum size in bytes of attachment content loaded into memory
+	maxAttachmentSize = 20 << 20
+)
+
+const (
+	projectsResourceTemplate    = "/projects.json?include=trackers,enabled_modules&offset=%d&limit=%d"
 	projectLinkTemplate         = "/projects/%d"
-	projectResourceTemplate     = "/projects/%d.json?include=trackers"
-	projectsIssuesTemplate      = "/projects/%d/issues.json?offset=%d&limit=%d&assigned_to_id=me"
+	projectResourceTemplate     = "/projects/%d.json?include=trackers,issue_custom_fields,enabled_modules"
+	projectIdentifierTemplate   = "/projects/%s.json?include=trackers,issue_custom_fields,enabled_modules"
+	projectsIssuesTemplate      = "/projects/%d/issues.json?offset=%d&limit=%d"
 	createIssuesTemplate        = "/projects/%d/issues.json"
-	timeEntriesResourceTemplate = "/time_entries.json?user_id=me&spent_on=%s&limit=100"
+	timeEntriesResourceTemplate = "/time_entries.json?user_id=me&spent_on=%s&offset=%d&limit=%d"
 )
 
-func projectIssuesResource(id entities.ProjectID, p entities.Pagination) string {
+func projectsResource(f entities.ProjectFilter, p entities.Pagination) string {
+	var status string
+	if f.ActiveOnly {
+		status = fmt.Sprintf("&status=%d", projectStatusActive)
+	}
 	if p.Offset == 0 && p.Limit == 0 {
-		return fmt.Sprintf(projectsIssuesTemplate, id, 0, 100)
+		return fmt.Sprintf(projectsResourceTemplate, 0, projectsPageLimit) + status
 	}
-	return fmt.Sprintf(projectsIssuesTemplate, id, p.Offset, p.Limit)
+	return fmt.Sprintf(projectsResourceTemplate, p.Offset, p.Limit) + status
 }
 
-func timeEntriesResource(date int64) string {
-	return fmt.Sprintf(timeEntriesResourceTemplate, secondsToDate(date))
+func projectIssuesResource(id entities.ProjectID, f entities.IssueFilter, s []entities.IssueSort, p entities.Pagination) (string, error) {
+	q, err := issuesQuery(f, s)
+	if err != nil {
+		return "", err
+	}
+	if p.Offset == 0 && p.Limit == 0 {
+		return fmt.Sprintf(projectsIssuesTemplate, id, 0, 100) + "&" + q.Encode(), nil
+	}
+	return fmt.Sprintf(projectsIssuesTemplate, id, p.Offset, p.Limit) + "&" + q.Encode(), nil
+}
+
+//issuesQuery maps issue filter and sort to redmine issues query parameters
+func issuesQuery(f entities.IssueFilter, s []entities.IssueSort) (url.Values, error) {
+	q := url.Values{}
+	if f.QueryID != 0 {
+		q.Set("query_id", strconv.FormatInt(int64(f.QueryID), 10))
+		return q, setIssuesSort(q, s)
+	}
+	switch f.Status {
+	case "", entities.IssueStatusOpen:
+	case entities.IssueStatusClosed:
+		q.Set("status_id", "closed")
+	case entities.IssueStatusAll:
+		q.Set("status_id", "*")
+	default:
+		return nil, errors.Wrapf(entities.ErrIssueFilter, "unknown status %s", f.Status)
+	}
+	switch f.Assignee {
+	case "", entities.AssigneeMe:
+		q.Set("assigned_to_id", "me")
+	case entities.AssigneeAny:
+	case entities.AssigneeNone:
+		q.Set("assigned_to_id", "!*")
+	case entities.AssigneeUser:
+		if f.AssigneeID == 0 {
+			return nil, errors.Wrap(entities.ErrIssueFilter, "assignee ID is required for user assignee")
+		}
+		q.Set("assigned_to_id", strconv.FormatInt(f.AssigneeID, 10))
+	default:
+		return nil, errors.Wrapf(entities.ErrIssueFilter, "unknown assignee %s", f.Assignee)
+	}
+	if len(f.TrackerIDs) > 0 {
+		ids := make([]string, len(f.TrackerIDs))
+		for i, id := range f.TrackerIDs {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+		q.Set("tracker_id", strings.Join(ids, "|"))
+	}
+	if f.VersionID != 0 {
+		q.Set("fixed_version_id", strconv.FormatInt(int64(f.VersionID), 10))
+	}
+	if f.UpdatedSince != 0 {
+		q.Set("updated_on", ">="+secondsToTimestamp(f.UpdatedSince))
+	}
+	if f.CreatedSince != 0 {
+		q.Set("created_on", ">="+secondsToTimestamp(f.CreatedSince))
+	}
+	if f.Subject != "" {
+		q.Set("subject", "~"+f.Subject)
+	}
+	return q, setIssuesSort(q, s)
+}
+
+func setIssuesSort(q url.Values, s []entities.IssueSort) error {
+	if len(s) == 0 {
+		return nil
+	}
+	fields := make([]string, len(s))
+	for i, sf := range s {
+		if sf.Field == "" {
+			return errors.Wrap(entities.ErrIssueFilter, "empty sort field")
+		}
+		fields[i] = sf.Field
+		if sf.Desc {
+			fields[i] += ":desc"
+		}
+	}
+	q.Set("sort", strings.Join(fields, ","))
+	return nil
+}
+
+func timeEntriesResource(date int64, p entities.Pagination) string {
+	return fmt.Sprintf(timeEntriesResourceTemplate, secondsToDate(date), p.Offset, p.Limit)
+}
+
+func reportsRangeResource(from, to int64, p entities.Pagination) string {
+	if p.Offset == 0 && p.Limit == 0 {
+		return fmt.Sprintf(reportsRangeResourceTemplate, secondsToDate(from), secondsToDate(to), 0, 100)
+	}
+	return fmt.Sprintf(reportsRangeResourceTemplate, secondsToDate(from), secondsToDate(to), p.Offset, p.Limit)
+}
+
+var searchTypes = map[string]bool{
+	entities.SearchIssues:     true,
+	entities.SearchWikiPages:  true,
+	entities.SearchNews:       true,
+	entities.SearchDocuments:  true,
+	entities.SearchChangesets: true,
+	entities.SearchMessages:   true,
+	entities.SearchProjects:   true,
+}
+
+func searchQueryResource(s entities.SearchQuery, p entities.Pagination) (string, error) {
+	if strings.TrimSpace(s.Text) == "" {
+		return "", errors.Wrap(entities.ErrSearchQuery, "empty search text")
+	}
+	q := url.Values{}
+	q.Set("q", s.Text)
+	for _, t := range s.Types {
+		if !searchTypes[t] {
+			return "", errors.Wrapf(entities.ErrSearchQuery, "unknown resource type %s", t)
+		}
+		q.Set(t, "1")
+	}
+	if s.TitlesOnly {
+		q.Set("titles_only", "1")
+	}
+	if p.Offset == 0 && p.Limit == 0 {
+		p.Limit = 100
+	}
+	q.Set("offset", strconv.Itoa(p.Offset))
+	q.Set("limit", strconv.Itoa(p.Limit))
+	resource := searchResource
+	if s.ProjectID != 0 {
+		resource = fmt.Sprintf(projectSearchResourceTemplate, s.ProjectID)
+	}
+	return resource + "?" + q.Encode(), nil
+}
+
+//issueChangesResource returns issues of all statuses updated since cursor ordered by update time and ID
+func issueChangesResource(c entities.IssueCursor, p entities.Pagination) string {
+	q := url.Values{}
+	q.Set("status_id", "*")
+	q.Set("sort", "updated_on,id")
+	q.Set("offset", strconv.Itoa(p.Offset))
+	q.Set("limit", strconv.Itoa(p.Limit))
+	if c.Updated != 0 {
+		q.Set("updated_on", ">="+secondsToTimestamp(c.Updated))
+	}
+	return issuesResource + "?" + q.Encode()
+}
+
+func projectMembershipsResource(id entities.ProjectID, p entities.Pagination) string {
+	if p.Offset == 0 && p.Limit == 0 {
+		return fmt.Sprintf(projectMembershipsTemplate, id, 0, 100)
+	}
+	return fmt.Sprintf(projectMembershipsTemplate, id, p.Offset, p.Limit)
+}
+
+func queriesResource(p entities.Pagination) string {
+	return fmt.Sprintf(queriesResourceTemplate, p.Offset, p.Limit)
+}
+
+func issueCategoriesResource(id entities.ProjectID) string {
+	return fmt.Sprintf(issueCategoriesTemplate, id)
+}
+
+func wikiIndexResource(id entities.ProjectID) string {
+	return fmt.Sprintf(wikiIndexResourceTemplate, id)
+}
+
+//wikiPageResource returns resource of page version, zero version is current one
+func wikiPageResource(id entities.ProjectID, title string, version int64) string {
+	t := strings.Replace(url.QueryEscape(title), "+", "%20", -1)
+	if version == 0 {
+		return fmt.Sprintf(wikiPageResourceTemplate, id, t)
+	}
+	return fmt.Sprintf(wikiPageVersionResourceTemplate, id, t, version)
+}
+
+func reportResource(id entities.ReportID) string {
+	return fmt.Sprintf(reportResourceTemplate, id)
 }
 
 func issueByID(id entities.IssueID) string {
@@ -41,6 +264,46 @@ func issueByIDResource(id entities.IssueID) string {
 	return issueByID(id) + ".json"
 }
 
+func issueWithDetailsResource(id entities.IssueID) string {
+	return issueByIDResource(id) + "?include=relations,children"
+}
+
+func issueWithAllowedStatusesResource(id entities.IssueID) string {
+	return issueByIDResource(id) + "?include=allowed_statuses"
+}
+
+func issueRelationsResource(id entities.IssueID) string {
+	return fmt.Sprintf(issueRelationsResourceTemplate, id)
+}
+
+func relationResource(id entities.RelationID) string {
+	return fmt.Sprintf(relationResourceTemplate, id)
+}
+
+func projectVersionsResource(id entities.ProjectID) string {
+	return fmt.Sprintf(projectVersionsResourceTemplate, id)
+}
+
+func issueWithJournalsResource(id entities.IssueID) string {
+	return issueByIDResource(id) + "?include=journals"
+}
+
+func issueWithAttachmentsResource(id entities.IssueID) string {
+	return issueByIDResource(id) + "?include=attachments"
+}
+
+func uploadsResource(filename string) string {
+	return fmt.Sprintf(uploadsResourceTemplate, url.QueryEscape(filename))
+}
+
+func attachmentResource(id entities.AttachmentID) string {
+	return fmt.Sprintf(attachmentResourceTemplate, id)
+}
+
+func attachmentDownloadResource(id entities.AttachmentID) string {
+	return fmt.Sprintf(attachmentDownloadResourceTemplate, id)
+}
+
 func projectByIDLink(id entities.ProjectID) string {
 	return fmt.Sprintf(projectLinkTemplate, id)
 }
@@ -49,6 +312,10 @@ func projectByIDResource(id entities.ProjectID) string {
 	return fmt.Sprintf(projectResourceTemplate, id)
 }
 
+func projectByIdentifierResource(identifier string) string {
+	return fmt.Sprintf(projectIdentifierTemplate, identifier)
+}
+
 func createIssueResource(projectID entities.ProjectID) string {
 	return fmt.Sprintf(createIssuesTemplate, projectID)
 }
@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -25,13 +27,37 @@ const (
 	get         = "GET"
 	post        = "POST"
 	put         = "PUT"
+	del         = "DELETE"
+
+	projectStatusActive   = 1
+	projectStatusClosed   = 5
+	projectStatusArchived = 9
+
+	apiKeyHeader     = "X-Redmine-API-Key"
+	switchUserHeader = "X-Redmine-Switch-User"
+
+	relationCopiedTo = "copied_to"
+
+	//issueFieldProject lists project in Issue.Fields of moved issue, it is not exposed to clients
+	//as moved issue needs type, category, version and assignee remapping
+	issueFieldProject entities.IssueField = "ProjectID"
 )
 
 var (
 	validateStatusOK      = validateStatus(http.StatusOK)
 	validateStatusCreated = validateStatus(http.StatusCreated)
+	//validateStatusNoContent accepts empty response of PUT and DELETE requests,
+	//older redmine versions reply with 200 instead of 204
+	validateStatusNoContent = validateStatusOneOf(http.StatusOK, http.StatusNoContent)
 
 	errNotFound = errors.New("not found")
+	errTooLarge = errors.New("response body too large")
+
+	projectStatuses = map[int]string{
+		projectStatusActive:   entities.ProjectStatusActive,
+		projectStatusClosed:   entities.ProjectStatusClosed,
+		projectStatusArchived: entities.ProjectStatusArchived,
+	}
 )
 
 type requestOpts struct {
@@ -43,6 +69,12 @@ type requestOpts struct {
 	body               interface{}
 	result             interface{}
 	validateStatusFunc func(int) error
+	// rawBody is sent as is with application/octet-stream content type instead of body
+	rawBody []byte
+	// rawResult receives response body as is instead of result on 200 OK response
+	rawResult io.Writer
+	// rawLimit fails request with errTooLarge if positive and response body exceeds it
+	rawLimit int64
 }
 
 func redmineRequest(opts requestOpts) error {
@@ -60,6 +92,23 @@ func redmineRequest(opts requestOpts) error {
 	if resp.StatusCode == http.StatusForbidden {
 		return entities.ErrForbidden
 	}
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return errors.Wrapf(entities.ErrCredentials, "invalid or locked user to switch to %s", opts.tracker.Credentials.SwitchUser)
+	}
+	if opts.rawResult != nil && resp.StatusCode == http.StatusOK {
+		var body io.Reader = resp.Body
+		if opts.rawLimit > 0 {
+			body = io.LimitReader(resp.Body, opts.rawLimit+1)
+		}
+		n, err := io.Copy(opts.rawResult, body)
+		if err != nil {
+			return errors.Wrap(err, "failed to copy body")
+		}
+		if opts.rawLimit > 0 && n > opts.rawLimit {
+			return errTooLarge
+		}
+		return nil
+	}
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return errors.Wrap(err, "failed read body")
@@ -93,12 +142,26 @@ func validateStatus(expected int) func(s int) error {
 	}
 }
 
+func validateStatusOneOf(expected ...int) func(s int) error {
+	return func(s int) error {
+		for _, e := range expected {
+			if s == e {
+				return nil
+			}
+		}
+		return errors.Errorf("expected status codes: %v, actual: %d", expected, s)
+	}
+}
+
 func authRequest(opts requestOpts) (*http.Response, error) {
 	if opts.tracker.Type != redmineType {
 		return nil, errors.Wrapf(entities.ErrTrackerType, "invalid type: %s", opts.tracker.Type)
 	}
-	var bodyBytes []byte
-	if opts.body != nil {
+	contentType := "application/json"
+	bodyBytes := opts.rawBody
+	if opts.rawBody != nil {
+		contentType = "application/octet-stream"
+	} else if opts.body != nil {
 		var err error
 		bodyBytes, err = json.Marshal(opts.body)
 		if err != nil {
@@ -110,8 +173,8 @@ func authRequest(opts requestOpts) (*http.Response, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create http request for url %s", url)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(opts.tracker.Credentials.Login, opts.tracker.Credentials.Password)
+	req.Header.Set("Content-Type", contentType)
+	setAuth(req, opts.tracker.Credentials)
 	resp, err := opts.httpClient.Do(req.WithContext(opts.ctx))
 	if opts.ctx.Err() != nil {
 		return nil, opts.ctx.Err()
@@ -125,6 +188,19 @@ func authRequest(opts requestOpts) (*http.Response, error) {
 	return resp, nil
 }
 
+//setAuth authenticates request by API key if present,
+//basic auth is not available for accounts with two-factor login
+func setAuth(req *http.Request, c entities.Credentials) {
+	if c.SwitchUser != "" {
+		req.Header.Set(switchUserHeader, c.SwitchUser)
+	}
+	if c.APIKey != "" {
+		req.Header.Set(apiKeyHeader, c.APIKey)
+		return
+	}
+	req.SetBasicAuth(c.Login, c.Password)
+}
+
 func secondsToDate(sec int64) string {
 	if sec == 0 {
 		return ""
@@ -152,6 +228,91 @@ func toExternalServiceErr(b []byte) error {
 	return entities.NewTrackerValidationErr(strings.Join(redmineError.Errors, ". "))
 }
 
+func toReports(ts []timeEntry) []entities.Report {
+	reports := make([]entities.Report, len(ts))
+	for i, t := range ts {
+		reports[i] = toReport(t)
+	}
+	return reports
+}
+
+func toReport(t timeEntry) entities.Report {
+	var pid entities.ProjectID
+	var issueID entities.IssueID
+	var activityID int64
+	if t.Project != nil {
+		pid = entities.ProjectID(t.Project.ID)
+	}
+	if t.Issue != nil {
+		issueID = entities.IssueID(t.Issue.ID)
+	}
+	if t.Activity != nil {
+		activityID = t.Activity.ID
+	}
+	return entities.Report{
+		ID:           entities.ReportID(t.ID),
+		ProjectID:    pid,
+		IssueID:      issueID,
+		ActivityID:   activityID,
+		Comments:     t.Comments,
+		Duration:     hoursToSeconds(t.Hours),
+		Started:      dateToSeconds(t.SpentOn),
+		CustomFields: toCustomFields(t.CustomFields),
+	}
+}
+
+func toReportsSummary(ts []timeEntry) entities.ReportsSummary {
+	var s entities.ReportsSummary
+	byDay := make(map[int64]*entities.ReportsTotal)
+	byIssue := make(map[int64]*entities.ReportsTotal)
+	byProject := make(map[int64]*entities.ReportsTotal)
+	byActivity := make(map[int64]*entities.ReportsTotal)
+	for _, t := range ts {
+		d := hoursToSeconds(t.Hours)
+		s.Total += d
+		addToTotal(byDay, &idName{ID: dateToSeconds(t.SpentOn), Name: t.SpentOn}, d)
+		addToTotal(byIssue, t.Issue, d)
+		addToTotal(byProject, t.Project, d)
+		addToTotal(byActivity, t.Activity, d)
+	}
+	s.ByDay = sortedTotals(byDay)
+	s.ByIssue = sortedTotals(byIssue)
+	s.ByProject = sortedTotals(byProject)
+	s.ByActivity = sortedTotals(byActivity)
+	return s
+}
+
+//addToTotal adds duration d to group of key, nil key is grouped with zero ID
+func addToTotal(totals map[int64]*entities.ReportsTotal, key *idName, d int64) {
+	if key == nil {
+		key = &idName{}
+	}
+	total, ok := totals[key.ID]
+	if !ok {
+		total = &entities.ReportsTotal{
+			ID:   key.ID,
+			Name: key.Name,
+		}
+		totals[key.ID] = total
+	}
+	total.Duration += d
+}
+
+func sortedTotals(totals map[int64]*entities.ReportsTotal) []entities.ReportsTotal {
+	res := make([]entities.ReportsTotal, 0, len(totals))
+	for _, t := range totals {
+		res = append(res, *t)
+	}
+	sort.Sort(byTotalID(res))
+	return res
+}
+
+type byTotalID []entities.ReportsTotal
+
+func (t byTotalID) Len() int           { return len(t) }
+func (t byTotalID) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t byTotalID) Less(i, j int) bool { return t[i].ID < t[j].ID }
+
 func toIssues(ir issuesRoot, tr entities.Tracker) []entities.Issue {
 	issues := make([]entities.Issue, len(ir.Issues))
 	for i, issue := range ir.Issues {
@@ -162,54 +323,675 @@ func toIssues(ir issuesRoot, tr entities.Tracker) []entities.Issue {
 
 func reportToTimeEntry(rep entities.Report) *timeEntryRoot {
 	return &timeEntryRoot{timeEntry{
-		ActivityID: rep.ActivityID,
-		IssueID:    int64(rep.IssueID),
-		Hours:      secondsToHours(rep.Duration),
-		Comments:   rep.Comments,
-		SpentOn:    secondsToDate(rep.Started),
+		ActivityID:   rep.ActivityID,
+		IssueID:      int64(rep.IssueID),
+		Hours:        secondsToHours(rep.Duration),
+		Comments:     rep.Comments,
+		SpentOn:      secondsToDate(rep.Started),
+		CustomFields: fromCustomFields(rep.CustomFields),
 	}}
 }
 
+//toReportParams converts report to update request body.
+//Fields listed in rep.Fields are sent with zero values except of required activity, hours and date,
+//if no fields are listed only fields with non zero value are sent.
+func toReportParams(rep entities.Report) *timeEntryParamsRoot {
+	listed := make(map[entities.ReportField]bool, len(rep.Fields))
+	for _, f := range rep.Fields {
+		listed[f] = true
+	}
+	p := timeEntryParams{}
+	set := func(f entities.ReportField, key string, zero bool, value interface{}) {
+		if len(listed) == 0 && zero || len(listed) > 0 && !listed[f] {
+			return
+		}
+		p[key] = value
+	}
+	required := func(f entities.ReportField, key string, zero bool, value interface{}) {
+		if !zero {
+			set(f, key, false, value)
+		}
+	}
+	set(entities.ReportFieldIssue, "issue_id", rep.IssueID == 0, nullableID(rep.IssueID))
+	set(entities.ReportFieldComments, "comments", rep.Comments == "", rep.Comments)
+	set(entities.ReportFieldCustomFields, "custom_fields", len(rep.CustomFields) == 0, customFieldsParam(rep.CustomFields))
+	required(entities.ReportFieldActivity, "activity_id", rep.ActivityID == 0, rep.ActivityID)
+	required(entities.ReportFieldDuration, "hours", rep.Duration == 0, secondsToHours(rep.Duration))
+	required(entities.ReportFieldStarted, "spent_on", rep.Started == 0, secondsToDate(rep.Started))
+	return &timeEntryParamsRoot{
+		TimeEntry: p,
+	}
+}
+
 func toIssue(i issue, tr entities.Tracker) entities.Issue {
-	var t entities.TypeID
+	var t, st, pr entities.TypeID
+	var v, a, c *entities.TypeID
 	var pid entities.ProjectID
+	var parentID entities.IssueID
 	if i.Tracker != nil {
 		t = entities.TypeID{
 			ID:   i.Tracker.ID,
 			Name: i.Tracker.Name,
 		}
 	}
+	if i.Status != nil {
+		st = entities.TypeID{
+			ID:   i.Status.ID,
+			Name: i.Status.Name,
+		}
+	}
+	if i.FixedVersion != nil {
+		v = &entities.TypeID{
+			ID:   i.FixedVersion.ID,
+			Name: i.FixedVersion.Name,
+		}
+	}
+	if i.Priority != nil {
+		pr = entities.TypeID{
+			ID:   i.Priority.ID,
+			Name: i.Priority.Name,
+		}
+	}
+	if i.Category != nil {
+		c = &entities.TypeID{
+			ID:   i.Category.ID,
+			Name: i.Category.Name,
+		}
+	}
+	if i.AssignedTo != nil {
+		a = &entities.TypeID{
+			ID:   i.AssignedTo.ID,
+			Name: i.AssignedTo.Name,
+		}
+	}
 	if i.Project != nil {
 		pid = entities.ProjectID(i.Project.ID)
 	}
+	if i.Parent != nil {
+		parentID = entities.IssueID(i.Parent.ID)
+	}
 	issueID := entities.IssueID(i.ID)
 	return entities.Issue{
-		ID:          issueID,
-		Title:       i.Subject,
-		Type:        t,
-		Description: i.Description,
-		Estimate:    hoursToSeconds(i.EstimatedHours),
-		DueDate:     dateToSeconds(i.DueDate),
+		ID:            issueID,
+		Title:         i.Subject,
+		Type:          t,
+		Status:        st,
+		Version:       v,
+		Description:   i.Description,
+		Estimate:      hoursToSeconds(i.EstimatedHours),
+		StartDate:     dateToSeconds(i.StartDate),
+		DueDate:       dateToSeconds(i.DueDate),
+		Private:       i.IsPrivate,
+		ProjectID:     pid,
+		Done:          entities.Progress(i.DoneRatio),
+		Spent:         hoursToSeconds(i.SpentHours),
+		URL:           fullURL(tr, issueByID(issueID)),
+		Created:       timeToSeconds(i.CreatedOn),
+		Updated:       timeToSeconds(i.UpdatedOn),
+		CustomFields:  toCustomFields(i.CustomFields),
+		Relations:     toRelations(i.Relations),
+		ParentID:      parentID,
+		Children:      toIssueChildren(i.Children),
+		TotalSpent:    hoursToSeconds(i.TotalSpentHours),
+		Assignee:      a,
+		Priority:      pr,
+		Category:      c,
+		TotalEstimate: hoursToSeconds(i.TotalEstimatedHours),
+	}
+}
+
+func toIssueChildren(cs []issueChild) []entities.IssueChild {
+	if len(cs) == 0 {
+		return nil
+	}
+	children := make([]entities.IssueChild, len(cs))
+	for i, c := range cs {
+		var t entities.TypeID
+		if c.Tracker != nil {
+			t = entities.TypeID{
+				ID:   c.Tracker.ID,
+				Name: c.Tracker.Name,
+			}
+		}
+		children[i] = entities.IssueChild{
+			ID:       entities.IssueID(c.ID),
+			Type:     t,
+			Title:    c.Subject,
+			Children: toIssueChildren(c.Children),
+		}
+	}
+	return children
+}
+
+//toIssueParams converts issue to request body, toIssue is reverse conversion.
+//Fields listed in i.Fields are sent with null for zero values except of required type, status and priority,
+//if no fields are listed only fields with non zero value are sent.
+//Project is sent only when issueFieldProject is listed.
+func toIssueParams(i entities.Issue) *issueParamsRoot {
+	listed := make(map[entities.IssueField]bool, len(i.Fields))
+	for _, f := range i.Fields {
+		listed[f] = true
+	}
+	p := issueParams{}
+	set := func(f entities.IssueField, key string, zero bool, value interface{}) {
+		if len(listed) == 0 && zero || len(listed) > 0 && !listed[f] {
+			return
+		}
+		p[key] = value
+	}
+	required := func(f entities.IssueField, key string, zero bool, value interface{}) {
+		if !zero {
+			set(f, key, false, value)
+		}
+	}
+	if listed[issueFieldProject] {
+		required(issueFieldProject, "project_id", i.ProjectID == 0, int64(i.ProjectID))
+	}
+	if i.ID != 0 {
+		p["id"] = int64(i.ID)
+	}
+	set(entities.IssueFieldTitle, "subject", i.Title == "", i.Title)
+	set(entities.IssueFieldDescription, "description", i.Description == "", i.Description)
+	required(entities.IssueFieldType, "tracker_id", i.Type.ID == 0, i.Type.ID)
+	required(entities.IssueFieldStatus, "status_id", i.Status.ID == 0, i.Status.ID)
+	required(entities.IssueFieldPriority, "priority_id", i.Priority.ID == 0, i.Priority.ID)
+	set(entities.IssueFieldAssignee, "assigned_to_id", i.Assignee == nil, typeIDParam(i.Assignee))
+	set(entities.IssueFieldCategory, "category_id", i.Category == nil, typeIDParam(i.Category))
+	set(entities.IssueFieldVersion, "fixed_version_id", i.Version == nil, typeIDParam(i.Version))
+	set(entities.IssueFieldParent, "parent_issue_id", i.ParentID == 0, nullableID(i.ParentID))
+	set(entities.IssueFieldStartDate, "start_date", i.StartDate == 0, dateParam(i.StartDate))
+	set(entities.IssueFieldDueDate, "due_date", i.DueDate == 0, dateParam(i.DueDate))
+	set(entities.IssueFieldEstimate, "estimated_hours", i.Estimate == 0, hoursParam(i.Estimate))
+	set(entities.IssueFieldDone, "done_ratio", i.Done == 0, int(i.Done))
+	set(entities.IssueFieldPrivate, "is_private", !i.Private, i.Private)
+	set(entities.IssueFieldCustomFields, "custom_fields", len(i.CustomFields) == 0, customFieldsParam(i.CustomFields))
+	return &issueParamsRoot{
+		Issue: p,
+	}
+}
+
+//typeIDParam returns nil for nil t, nullableID clears field for zero ID of t
+func typeIDParam(t *entities.TypeID) interface{} {
+	if t == nil {
+		return nil
+	}
+	return nullableID(t.ID)
+}
+
+func dateParam(sec int64) interface{} {
+	if sec == 0 {
+		return nil
+	}
+	return secondsToDate(sec)
+}
+
+func hoursParam(sec int64) interface{} {
+	if sec == 0 {
+		return nil
+	}
+	return secondsToHours(sec)
+}
+
+func customFieldsParam(fields []entities.CustomField) []customField {
+	cfs := fromCustomFields(fields)
+	if cfs == nil {
+		return []customField{}
+	}
+	return cfs
+}
+
+//projectMapping holds issue types, categories, versions and members of target project for moved and copied issues.
+//Nil members means that members of project are unknown, versions are loaded only for moved issues.
+type projectMapping struct {
+	issueTypes []entities.TypeID
+	categories []entities.IssueCategory
+	versions   []entities.Version
+	members    map[int64]bool
+}
+
+//issueType returns typ if it is enabled in project, first project type otherwise
+func (m *projectMapping) issueType(typ entities.TypeID) entities.TypeID {
+	for _, it := range m.issueTypes {
+		if it.ID == typ.ID {
+			return typ
+		}
+	}
+	if len(m.issueTypes) == 0 {
+		return typ
+	}
+	return m.issueTypes[0]
+}
+
+//category returns project category with the same name as c,
+//zero TypeID clears category when project has no such category
+func (m *projectMapping) category(c *entities.TypeID) *entities.TypeID {
+	if c == nil {
+		return nil
+	}
+	for _, pc := range m.categories {
+		if strings.EqualFold(pc.Name, c.Name) {
+			return &entities.TypeID{ID: pc.ID, Name: pc.Name}
+		}
+	}
+	return &entities.TypeID{}
+}
+
+//version returns v if it is available in project, project version with the same name as v otherwise,
+//zero TypeID clears version when project has no such version
+func (m *projectMapping) version(v *entities.TypeID) *entities.TypeID {
+	if v == nil {
+		return nil
+	}
+	for _, pv := range m.versions {
+		if int64(pv.ID) == v.ID {
+			return v
+		}
+	}
+	for _, pv := range m.versions {
+		if strings.EqualFold(pv.Name, v.Name) {
+			return &entities.TypeID{ID: int64(pv.ID), Name: pv.Name}
+		}
+	}
+	return &entities.TypeID{}
+}
+
+//assignee returns a if it is member of project, zero TypeID clears assignee otherwise
+func (m *projectMapping) assignee(a *entities.TypeID) *entities.TypeID {
+	if a == nil || m.members == nil || m.members[a.ID] {
+		return a
+	}
+	return &entities.TypeID{}
+}
+
+//toIssueCopy prepares new issue with fields of i for project described by m.
+//Unassigned issue stays unassigned, version is not copied as it belongs to source project,
+//status is not copied as it could be not allowed for remapped type so copy gets default status.
+func toIssueCopy(i entities.Issue, parentID entities.IssueID, m *projectMapping) entities.NewIssue {
+	assignee := m.assignee(i.Assignee)
+	if assignee == nil {
+		assignee = &entities.TypeID{}
+	}
+	return entities.NewIssue{
+		Issue: entities.Issue{
+			Title:        i.Title,
+			Description:  i.Description,
+			Priority:     i.Priority,
+			Assignee:     assignee,
+			Category:     m.category(i.Category),
+			ParentID:     parentID,
+			StartDate:    i.StartDate,
+			DueDate:      i.DueDate,
+			Estimate:     i.Estimate,
+			Done:         i.Done,
+			Private:      i.Private,
+			CustomFields: i.CustomFields,
+		},
+		Type: m.issueType(i.Type).ID,
+	}
+}
+
+func toIssuePriorities(pr issuePrioritiesRoot) []entities.IssuePriority {
+	priorities := make([]entities.IssuePriority, len(pr.IssuePriorities))
+	for i, p := range pr.IssuePriorities {
+		priorities[i] = entities.IssuePriority{
+			ID:        p.ID,
+			Name:      p.Name,
+			IsDefault: p.IsDefault,
+		}
+	}
+	return priorities
+}
+
+func toIssueCategories(cr issueCategoriesRoot) []entities.IssueCategory {
+	categories := make([]entities.IssueCategory, len(cr.IssueCategories))
+	for i, c := range cr.IssueCategories {
+		var pid entities.ProjectID
+		if c.Project != nil {
+			pid = entities.ProjectID(c.Project.ID)
+		}
+		categories[i] = entities.IssueCategory{
+			ID:        c.ID,
+			ProjectID: pid,
+			Name:      c.Name,
+		}
+	}
+	return categories
+}
+
+func toIssueStatuses(sr issueStatusesRoot) []entities.IssueStatus {
+	statuses := make([]entities.IssueStatus, len(sr.IssueStatuses))
+	for i, s := range sr.IssueStatuses {
+		statuses[i] = entities.IssueStatus{
+			ID:       s.ID,
+			Name:     s.Name,
+			IsClosed: s.IsClosed,
+		}
+	}
+	return statuses
+}
+
+func toComments(js []journal) []entities.Comment {
+	var comments []entities.Comment
+	for _, j := range js {
+		if j.Notes == "" {
+			continue
+		}
+		var author entities.User
+		if j.User != nil {
+			author = entities.User{
+				ID:   j.User.ID,
+				Name: j.User.Name,
+			}
+		}
+		comments = append(comments, entities.Comment{
+			ID:      j.ID,
+			Author:  author,
+			Text:    j.Notes,
+			Private: j.PrivateNotes,
+			Created: timeToSeconds(j.CreatedOn),
+		})
+	}
+	return comments
+}
+
+func toUploads(as []entities.NewAttachment) []upload {
+	uploads := make([]upload, len(as))
+	for i, a := range as {
+		uploads[i] = upload{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Description: a.Description,
+		}
+	}
+	return uploads
+}
+
+func toAttachments(as []attachment) []entities.Attachment {
+	attachments := make([]entities.Attachment, len(as))
+	for i, a := range as {
+		attachments[i] = toAttachment(a)
+	}
+	return attachments
+}
+
+func toAttachment(a attachment) entities.Attachment {
+	var author entities.User
+	if a.Author != nil {
+		author = entities.User{
+			ID:   a.Author.ID,
+			Name: a.Author.Name,
+		}
+	}
+	return entities.Attachment{
+		ID:          entities.AttachmentID(a.ID),
+		Filename:    a.Filename,
+		Size:        a.Filesize,
+		ContentType: a.ContentType,
+		Description: a.Description,
+		URL:         a.ContentURL,
+		Author:      author,
+		Created:     timeToSeconds(a.CreatedOn),
+	}
+}
+
+func toCustomFields(cfs []customField) []entities.CustomField {
+	var fields []entities.CustomField
+	for _, cf := range cfs {
+		fields = append(fields, entities.CustomField{
+			ID:       cf.ID,
+			Name:     cf.Name,
+			Multiple: cf.Multiple,
+			Values:   customFieldValues(cf.Value),
+		})
+	}
+	return fields
+}
+
+func customFieldValues(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func fromCustomFields(fields []entities.CustomField) []customField {
+	var cfs []customField
+	for _, f := range fields {
+		cf := customField{ID: f.ID}
+		switch {
+		case f.Multiple:
+			values := f.Values
+			if values == nil {
+				values = []string{}
+			}
+			cf.Value = values
+		case len(f.Values) > 0:
+			cf.Value = f.Values[0]
+		default:
+			cf.Value = ""
+		}
+		cfs = append(cfs, cf)
+	}
+	return cfs
+}
+
+func toCustomFieldDefinitions(ids []idName) []entities.CustomFieldDefinition {
+	var defs []entities.CustomFieldDefinition
+	for _, id := range ids {
+		defs = append(defs, entities.CustomFieldDefinition{
+			ID:   id.ID,
+			Name: id.Name,
+		})
+	}
+	return defs
+}
+
+func addCustomFieldDefinitions(defs []entities.CustomFieldDefinition, cfr customFieldsRoot) {
+	byID := make(map[int64]customFieldDefinition, len(cfr.CustomFields))
+	for _, cf := range cfr.CustomFields {
+		if cf.CustomizedType == "issue" {
+			byID[cf.ID] = cf
+		}
+	}
+	for i := range defs {
+		cf, ok := byID[defs[i].ID]
+		if !ok {
+			continue
+		}
+		defs[i].Format = cf.FieldFormat
+		defs[i].Required = cf.IsRequired
+		defs[i].Multiple = cf.Multiple
+		defs[i].DefaultValue = cf.DefaultValue
+		for _, v := range cf.PossibleValues {
+			defs[i].PossibleValues = append(defs[i].PossibleValues, v.Value)
+		}
+	}
+}
+
+func toRelations(rs []relation) []entities.Relation {
+	var relations []entities.Relation
+	for _, r := range rs {
+		relations = append(relations, toRelation(r))
+	}
+	return relations
+}
+
+func toRelation(r relation) entities.Relation {
+	var delay int
+	if r.Delay != nil {
+		delay = *r.Delay
+	}
+	return entities.Relation{
+		ID:        entities.RelationID(r.ID),
+		IssueID:   entities.IssueID(r.IssueID),
+		IssueToID: entities.IssueID(r.IssueToID),
+		Type:      r.RelationType,
+		Delay:     delay,
+	}
+}
+
+func toRelationRoot(r entities.Relation) *relationRoot {
+	rel := relation{
+		IssueToID:    int64(r.IssueToID),
+		RelationType: r.Type,
+	}
+	if r.Delay != 0 {
+		delay := r.Delay
+		rel.Delay = &delay
+	}
+	return &relationRoot{Relation: rel}
+}
+
+func toProjectMembers(ms []membership) []entities.ProjectMember {
+	members := make([]entities.ProjectMember, 0, len(ms))
+	for _, m := range ms {
+		member := entities.ProjectMember{
+			ID:    m.ID,
+			Roles: idNamesToTypeID(m.Roles),
+		}
+		switch {
+		case m.User != nil:
+			member.User = entities.TypeID{ID: m.User.ID, Name: m.User.Name}
+		case m.Group != nil:
+			member.User = entities.TypeID{ID: m.Group.ID, Name: m.Group.Name}
+			member.IsGroup = true
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+func toWikiPageInfos(ps []wikiPage) []entities.WikiPageInfo {
+	infos := make([]entities.WikiPageInfo, len(ps))
+	for i, p := range ps {
+		infos[i] = toWikiPageInfo(p)
+	}
+	return infos
+}
+
+func toWikiPageInfo(p wikiPage) entities.WikiPageInfo {
+	var parent string
+	if p.Parent != nil {
+		parent = p.Parent.Title
+	}
+	return entities.WikiPageInfo{
+		Title:   p.Title,
+		Parent:  parent,
+		Version: p.Version,
+		Created: timeToSeconds(p.CreatedOn),
+		Updated: timeToSeconds(p.UpdatedOn),
+	}
+}
+
+func toWikiPage(p wikiPage) entities.WikiPage {
+	var author entities.User
+	if p.Author != nil {
+		author = entities.User{
+			ID:   p.Author.ID,
+			Name: p.Author.Name,
+		}
+	}
+	return entities.WikiPage{
+		WikiPageInfo: toWikiPageInfo(p),
+		Text:         p.Text,
+		Author:       author,
+		Comments:     p.Comments,
+	}
+}
+
+func toSearchResults(rs []searchResult) []entities.SearchResult {
+	results := make([]entities.SearchResult, len(rs))
+	for i, r := range rs {
+		results[i] = entities.SearchResult{
+			ID:      r.ID,
+			Type:    r.Type,
+			Title:   r.Title,
+			URL:     r.URL,
+			Snippet: r.Description,
+			Updated: timeToSeconds(r.Datetime),
+		}
+	}
+	return results
+}
+
+//toSavedQueries converts queries available for project pid, all queries for zero pid
+func toSavedQueries(qs []query, pid entities.ProjectID) []entities.SavedQuery {
+	queries := make([]entities.SavedQuery, 0, len(qs))
+	for _, q := range qs {
+		if pid != 0 && q.ProjectID != 0 && q.ProjectID != int64(pid) {
+			continue
+		}
+		queries = append(queries, entities.SavedQuery{
+			ID:        entities.QueryID(q.ID),
+			Name:      q.Name,
+			Public:    q.IsPublic,
+			ProjectID: entities.ProjectID(q.ProjectID),
+		})
+	}
+	return queries
+}
+
+func toVersions(vs []version) []entities.Version {
+	versions := make([]entities.Version, len(vs))
+	for i, v := range vs {
+		versions[i] = toVersion(v)
+	}
+	return versions
+}
+
+func toVersion(v version) entities.Version {
+	var pid entities.ProjectID
+	if v.Project != nil {
+		pid = entities.ProjectID(v.Project.ID)
+	}
+	return entities.Version{
+		ID:          entities.VersionID(v.ID),
 		ProjectID:   pid,
-		Done:        entities.Progress(i.DoneRatio),
-		Spent:       hoursToSeconds(i.SpentHours),
-		URL:         fullURL(tr, issueByID(issueID)),
+		Name:        v.Name,
+		Description: v.Description,
+		Status:      v.Status,
+		Sharing:     v.Sharing,
+		DueDate:     dateToSeconds(v.DueDate),
 	}
 }
 
-func toIssueRoot(i entities.Issue) *issueRoot {
-	return &issueRoot{
-		Issue: issue{
-			DoneRatio:      int(i.Done),
-			ID:             int64(i.ID),
-			Subject:        i.Title,
-			DueDate:        secondsToDate(i.DueDate),
-			EstimatedHours: secondsToHours(i.Estimate),
-			TrackerID:      i.Type.ID,
+func toVersionRoot(v entities.Version) *versionRoot {
+	return &versionRoot{
+		Version: version{
+			Name:        v.Name,
+			Description: v.Description,
+			Status:      v.Status,
+			Sharing:     v.Sharing,
+			DueDate:     secondsToDate(v.DueDate),
 		},
 	}
 }
 
+func timeToSeconds(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+//seenIssue reports whether issue is at or before cursor c in changes feed
+func seenIssue(i entities.Issue, c entities.IssueCursor) bool {
+	return i.Updated < c.Updated || i.Updated == c.Updated && i.ID <= c.LastID
+}
+
+func secondsToTimestamp(s int64) string {
+	return time.Unix(s, 0).UTC().Format(time.RFC3339)
+}
+
 func secondsToHours(sec int64) float64 {
 	return float64(sec) / 3600
 }
@@ -218,6 +1000,64 @@ func hoursToSeconds(h float64) int64 {
 	return int64(h * 3600)
 }
 
+//pagesCount returns amount of pages with limit items required for total items
+func pagesCount(total, limit int) int {
+	return (total + limit - 1) / limit
+}
+
+//loadPages calls load for pages from 1 to pages-1 and returns first error.
+//At most parallelPagesLimit pages are loaded at once, first error cancels the rest of requests.
+//Page 0 is expected to be loaded by caller to find out amount of pages.
+func loadPages(ctx context.Context, pages int, load func(ctx context.Context, page int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pageC := make(chan int)
+	go func() {
+		defer close(pageC)
+		for page := 1; page < pages; page++ {
+			select {
+			case pageC <- page:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	errC := make(chan error, pages)
+	for w := 0; w < parallelPagesLimit && w < pages-1; w++ {
+		go func() {
+			for page := range pageC {
+				errC <- load(ctx, page)
+			}
+		}()
+	}
+	for page := 1; page < pages; page++ {
+		select {
+		case err := <-errC:
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+//pageOf returns bounds of page p in list with n items
+func pageOf(n int, p entities.Pagination) (from, to int) {
+	from, to = p.Offset, n
+	if from > n {
+		from = n
+	}
+	if from < 0 {
+		from = 0
+	}
+	if p.Limit > 0 && from+p.Limit < n {
+		to = from + p.Limit
+	}
+	return from, to
+}
+
 func toProjects(pr projectsRoot) []entities.Project {
 	ps := make([]entities.Project, len(pr.Projects))
 	for i, p := range pr.Projects {
@@ -227,13 +1067,73 @@ func toProjects(pr projectsRoot) []entities.Project {
 }
 
 func toProject(p project) entities.Project {
+	var parentID entities.ProjectID
+	if p.Parent != nil {
+		parentID = entities.ProjectID(p.Parent.ID)
+	}
+	var modules []string
+	for _, m := range p.EnabledModules {
+		modules = append(modules, m.Name)
+	}
 	return entities.Project{
-		ID:          entities.ProjectID(p.ID),
-		Title:       p.Name,
-		Description: p.Description,
-		IssueTypes:  idNamesToTypeID(p.Trackers),
+		ID:           entities.ProjectID(p.ID),
+		ParentID:     parentID,
+		Identifier:   p.Identifier,
+		Title:        p.Name,
+		Description:  p.Description,
+		Status:       projectStatuses[p.Status],
+		Modules:      modules,
+		Created:      timeToSeconds(p.CreatedOn),
+		Updated:      timeToSeconds(p.UpdatedOn),
+		IssueTypes:   idNamesToTypeID(p.Trackers),
+		CustomFields: toCustomFieldDefinitions(p.IssueCustomFields),
+	}
+
+}
+
+//filterProjects drops closed and archived projects if filter requires,
+//trackers before 4.1 ignore status param of projects request
+func filterProjects(ps []project, f entities.ProjectFilter) []project {
+	if !f.ActiveOnly {
+		return ps
+	}
+	active := make([]project, 0, len(ps))
+	for _, p := range ps {
+		if p.Status == 0 || p.Status == projectStatusActive {
+			active = append(active, p)
+		}
 	}
+	return active
+}
 
+//toProjectTree nests projects under their parents,
+//projects with parent missing from ps are placed on top level
+func toProjectTree(ps []entities.Project) []entities.ProjectNode {
+	present := make(map[entities.ProjectID]bool, len(ps))
+	for _, p := range ps {
+		present[p.ID] = true
+	}
+	children := make(map[entities.ProjectID][]entities.Project)
+	var roots []entities.Project
+	for _, p := range ps {
+		if p.ParentID == 0 || !present[p.ParentID] {
+			roots = append(roots, p)
+			continue
+		}
+		children[p.ParentID] = append(children[p.ParentID], p)
+	}
+	return toProjectNodes(roots, children)
+}
+
+func toProjectNodes(ps []entities.Project, children map[entities.ProjectID][]entities.Project) []entities.ProjectNode {
+	var nodes []entities.ProjectNode
+	for _, p := range ps {
+		nodes = append(nodes, entities.ProjectNode{
+			Project:  p,
+			Children: toProjectNodes(children[p.ID], children),
+		})
+	}
+	return nodes
 }
 
 func addActivities(ps []entities.Project, activityTypes timeEntryActivitiesRoot) {
@@ -289,6 +1189,20 @@ func issueIDFromURL(url entities.IssueURL) (entities.IssueID, error) {
 	return entities.IssueID(id), nil
 }
 
+//project identifiers allowed by Redmine, numeric project IDs match as well
+var (
+	projectIdentifierRegexp    = regexp.MustCompile(`^[a-z0-9_\-]+$`)
+	projectIdentifierURLRegexp = regexp.MustCompile(`/projects/([a-z0-9_\-]+)(/|\?|#|$)`)
+)
+
+func projectIdentifierFromURL(url entities.ProjectURL) (string, error) {
+	identifier := projectIdentifierURLRegexp.FindStringSubmatch(string(url))
+	if identifier == nil {
+		return "", errors.Wrapf(entities.ErrProjectURL, "invalid project URL %s", url)
+	}
+	return identifier[1], nil
+}
+
 func copyHeadersOnRedirect(req *http.Request, via []*http.Request) error {
 	if len(via) >= 10 {
 		return fmt.Errorf("too many redirects")
@@ -2,6 +2,7 @@ package redmine
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -277,6 +278,54 @@ func TestIDFromURL(t *testing.T) {
 	}
 }
 
+func TestIdentifierFromURL(t *testing.T) {
+	type test struct {
+		url                entities.ProjectURL
+		expectedIdentifier string
+		errExpected        bool
+	}
+	tests := []test{
+		{
+			url:                "https://redmine.example.com/projects/mobile-app",
+			expectedIdentifier: "mobile-app",
+		},
+		{
+			url:                "https://redmine.example.com/projects/mobile-app/issues?set_filter=1",
+			expectedIdentifier: "mobile-app",
+		},
+		{
+			url:                "/projects/time_guard2?jump=issues",
+			expectedIdentifier: "time_guard2",
+		},
+		{
+			url:                "/projects/223/wiki",
+			expectedIdentifier: "223",
+		},
+		{
+			url:         "/projects/",
+			errExpected: true,
+		},
+		{
+			url:         "/projects/Mobile App",
+			errExpected: true,
+		},
+		{
+			url:         "/issues/123",
+			errExpected: true,
+		},
+	}
+
+	for i, test := range tests {
+		identifier, err := projectIdentifierFromURL(test.url)
+		if test.errExpected && err == nil {
+			t.Errorf("Test %d. Errow was expected", i)
+		}
+		if test.expectedIdentifier != identifier {
+			t.Errorf("Test %d. Unexpected identifier expected %v | actual %v", i, test.expectedIdentifier, identifier)
+		}
+	}
+}
+
 func TestSecondsToHours(t *testing.T) {
 	h := secondsToHours(1800)
 	if h != 0.5 {
@@ -327,3 +376,55 @@ func TestAddLinks(t *testing.T) {
 		}
 	}
 }
+
+func TestCustomFieldsConversion(t *testing.T) {
+	var ir issueRoot
+	err := json.Unmarshal([]byte(`{"issue":{"custom_fields":[
+		{"id":1,"name":"Single","value":"one"},
+		{"id":2,"name":"Multi","multiple":true,"value":["a","b"]},
+		{"id":3,"name":"Empty","value":null}
+	]}}`), &ir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []entities.CustomField{
+		{ID: 1, Name: "Single", Values: []string{"one"}},
+		{ID: 2, Name: "Multi", Multiple: true, Values: []string{"a", "b"}},
+		{ID: 3, Name: "Empty"},
+	}
+	fields := toCustomFields(ir.Issue.CustomFields)
+	if !reflect.DeepEqual(expected, fields) {
+		t.Errorf("Unexpected custom fields %+v", fields)
+	}
+
+	b, err := json.Marshal(fromCustomFields(fields))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedJSON := `[{"id":1,"value":"one"},{"id":2,"value":["a","b"]},{"id":3,"value":""}]`
+	if string(b) != expectedJSON {
+		t.Errorf("Unexpected custom fields JSON %s", b)
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	tests := map[string]struct {
+		n        int
+		p        entities.Pagination
+		from, to int
+	}{
+		"Everything":      {10, entities.Pagination{}, 0, 10},
+		"First page":      {10, entities.Pagination{Limit: 3}, 0, 3},
+		"Middle page":     {10, entities.Pagination{Offset: 3, Limit: 3}, 3, 6},
+		"Last page":       {10, entities.Pagination{Offset: 9, Limit: 3}, 9, 10},
+		"Offset only":     {10, entities.Pagination{Offset: 4}, 4, 10},
+		"Offset overflow": {10, entities.Pagination{Offset: 11, Limit: 3}, 10, 10},
+		"Empty list":      {0, entities.Pagination{Offset: 1, Limit: 3}, 0, 0},
+	}
+	for label, test := range tests {
+		from, to := pageOf(test.n, test.p)
+		if from != test.from || to != test.to {
+			t.Errorf("Test %s unexpected bounds [%d:%d]", label, from, to)
+		}
+	}
+}